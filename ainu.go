@@ -0,0 +1,75 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ToAinuRomaji converts Ainu katakana into Ainu romaji, such that アイヌ
+// becomes aynu, ユカㇻ becomes yukar, and ト゚ becomes tu. Full size イ and ウ
+// following a vowel are final y and w, and small katakana are final
+// consonants. Any other characters are preserved.
+func ToAinuRomaji(s string) string {
+	in := []rune(s)
+	for i := 1; i < len(in); i++ {
+		if in[i] != 'イ' && in[i] != 'ウ' {
+			continue
+		}
+
+		if _, ok := kanaVowel(in[i-1]); ok && unicode.In(in[i-1], unicode.Katakana) {
+			if in[i] == 'イ' {
+				in[i] = 'y'
+			} else {
+				in[i] = 'w'
+			}
+		}
+	}
+
+	s = katakanaToAinu.Replace(string(in))
+	s = parseRomajiDoubles([]rune(s))
+	return strings.ReplaceAll(s, "ッ", "t") // a dangling small tsu is a final t.
+}
+
+// ToAinuKatakana converts Ainu romaji into Ainu katakana, such that aynu
+// becomes アイヌ, yukar becomes ユカㇻ, and tu becomes ト゚. Consonants which
+// do not precede a vowel are written as final consonants. Any other
+// characters are preserved.
+func ToAinuKatakana(s string) string {
+	in := []rune(strings.ToLower(s))
+	var b strings.Builder
+	for i := 0; i < len(in); {
+		if n, k := matchAinuSyllable(in[i:]); n > 0 {
+			b.WriteString(k)
+			i += n
+			continue
+		}
+
+		if k, ok := ainuFinals[in[i]]; ok {
+			b.WriteString(k)
+		} else if v, ok := ainuFinalsVowel[in[i]]; ok && i > 0 && v[in[i-1]] != "" {
+			b.WriteString(v[in[i-1]])
+		} else if in[i] != '\'' {
+			b.WriteRune(in[i])
+		}
+
+		i++
+	}
+
+	return b.String()
+}
+
+// matchAinuSyllable returns the length and katakana of the longest Ainu
+// romaji syllable at the start of r.
+func matchAinuSyllable(r []rune) (int, string) {
+	for n := 3; n > 0; n-- {
+		if n > len(r) {
+			continue
+		}
+
+		if k, ok := ainuSyllables[string(r[:n])]; ok {
+			return n, k
+		}
+	}
+
+	return 0, ""
+}
@@ -0,0 +1,77 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToAinuRomaji(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"アイヌ", "aynu"},  // final y
+		{"カムイ", "kamuy"}, // final y
+		{"チセ", "cise"},   // c row
+		{"ユカㇻ", "yukar"}, // final r
+		{"イランカㇻテ", "irankarte"},
+		{"アㇵ", "ah"},     // final h
+		{"ト゚ㇺ", "tum"},   // tu with combining handakuten
+		{"ト゜ㇺ", "tum"},   // tu with spacing handakuten
+		{"セ゚", "ce"},     // alternate ce
+		{"カㇷ゚", "kap"},   // final p
+		{"コタン", "kotan"}, // final n
+		{"ピㇼカ", "pirka"}, // final r after i
+		{"シㇰ", "sik"},    // final k
+		{"オㇱ", "os"},     // final s
+		{"ウタㇻ", "utar"},  // initial u
+		{"アウ", "aw"},     // final w
+		{"イェ", "ye"},     // ye
+		{"ウェン", "wen"},   // we
+		{"ホッケ", "hokke"}, // geminate
+		{"アッ", "at"},     // dangling small tsu
+		{"abc", "abc"},   // latin
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ToAinuRomaji(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestToAinuKatakana(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"aynu", "アイヌ"},
+		{"kamuy", "カムイ"},
+		{"cise", "チセ"},
+		{"yukar", "ユカㇻ"},
+		{"irankarte", "イランカㇻテ"},
+		{"ah", "アㇵ"},
+		{"tum", "ト゚ㇺ"},
+		{"kap", "カㇷ゚"},
+		{"kotan", "コタン"},
+		{"pirka", "ピㇼカ"},
+		{"sik", "シㇰ"},
+		{"os", "オㇱ"},
+		{"aw", "アウ"},
+		{"ye", "イェ"},
+		{"wen", "ウェン"},
+		{"AYNU", "アイヌ"},
+		{"a'a", "アア"},
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ToAinuKatakana(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestAinuRoundTrip(t *testing.T) {
+	for _, s := range []string{"aynu", "kamuy", "cise", "yukar", "irankarte", "pirka", "kotan", "wen", "tum"} {
+		require.Equal(t, s, ToAinuRomaji(ToAinuKatakana(s)), "testing %s", s)
+	}
+}
@@ -0,0 +1,82 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ResolveChoonpu replaces each katakana-hiragana prolonged sound mark (ー)
+// with the vowel kana of the preceding mora, written in the same script as
+// that mora, such that カード becomes カアド and かーど becomes かあど. Marks
+// which do not follow a voweled mora are left in place.
+func ResolveChoonpu(s string) string {
+	in := []rune(s)
+	for i := 1; i < len(in); i++ {
+		if in[i] != 'ー' {
+			continue
+		}
+
+		if v, ok := kanaVowel(in[i-1]); ok {
+			if unicode.In(in[i-1], unicode.Katakana) {
+				v = HiraganaToKatakana(v)
+			}
+			in[i] = v
+		}
+	}
+
+	return string(in)
+}
+
+// CollapseChoonpu is the inverse of ResolveChoonpu for katakana, replacing
+// any katakana vowel which repeats the vowel of the preceding mora with a
+// prolonged sound mark (ー), such that カアド becomes カード. Hiragana is
+// left unchanged.
+func CollapseChoonpu(s string) string {
+	in := []rune(s)
+	out := make([]rune, len(in))
+	copy(out, in)
+	for i := 1; i < len(in); i++ {
+		if !unicode.In(in[i], unicode.Katakana) || !unicode.In(in[i-1], unicode.Katakana) {
+			continue
+		}
+
+		if _, ok := kanaVowels[KatakanaToHiragana(in[i])]; !ok {
+			continue
+		}
+
+		if v, ok := kanaVowel(in[i-1]); ok && v == KatakanaToHiragana(in[i]) {
+			out[i] = 'ー'
+		}
+	}
+
+	return string(out)
+}
+
+// ToHiraganaResolved converts wapuro-hepburn romaji and katakana into the
+// equivalent hiragana in the same manner as ToHiragana, resolving any
+// prolonged sound marks into vowel kana, such that kaado and カード both
+// become かあど.
+func ToHiraganaResolved(s string) string {
+	return ResolveChoonpu(ToHiragana(s))
+}
+
+// ToKatakanaCollapsed converts wapuro-hepburn romaji and hiragana into the
+// equivalent katakana in the same manner as ToKatakana, collapsing any
+// repeated vowels into prolonged sound marks, such that kaado and かあど
+// both become カード.
+func ToKatakanaCollapsed(s string) string {
+	return CollapseChoonpu(ToKatakana(s))
+}
+
+// kanaVowel returns the hiragana vowel which a hiragana or katakana mora
+// ends in.
+func kanaVowel(r rune) (rune, bool) {
+	h := KatakanaToHiragana(r)
+	for v, row := range kanaVowels {
+		if strings.ContainsRune(row, h) {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,76 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveChoonpu(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"カード", "カアド"},
+		{"かーど", "かあど"},
+		{"セーラー", "セエラア"},
+		{"パーティー", "パアティイ"}, // small vowels
+		{"キャー", "キャア"},     // small ya
+		{"ニュース", "ニュウス"},   // small yu
+		{"ショー", "ショオ"},     // small yo
+		{"すごーーい", "すごおおい"}, // consecutive marks
+		{"ー", "ー"},         // nothing to resolve
+		{"ンー", "ンー"},       // moraic n has no vowel
+		{"abc-ー", "abc-ー"}, // latin
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ResolveChoonpu(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestCollapseChoonpu(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"カアド", "カード"},
+		{"セエラア", "セーラー"},
+		{"キャア", "キャー"},
+		{"ボウル", "ボウル"}, // differing vowels
+		{"かあど", "かあど"}, // hiragana is unchanged
+		{"カあド", "カあド"}, // mixed scripts
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, CollapseChoonpu(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestToHiraganaResolved(t *testing.T) {
+	tt := [][]string{
+		{"ka-do", "かあど"},
+		{"KA-DO", "かあど"},
+		{"カード", "かあど"},
+		{"se-ra-", "せえらあ"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v[1], ToHiraganaResolved(v[0]), "testing (%d) %s = %s", i, v[0], v[1])
+	}
+}
+
+func TestToKatakanaCollapsed(t *testing.T) {
+	tt := [][]string{
+		{"kaado", "カード"},
+		{"かあど", "カード"},
+		{"ka-do", "カード"},
+		{"seeraa", "セーラー"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v[1], ToKatakanaCollapsed(v[0]), "testing (%d) %s = %s", i, v[0], v[1])
+	}
+}
@@ -0,0 +1,190 @@
+package kana
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Collator orders Japanese strings in dictionary (gojūon) order, following
+// the rules of JIS X 4061. Strings are compared first by their kana ignoring
+// script, voicing and size, with each prolonged sound mark (ー) read as the
+// vowel of the preceding kana. Ties are broken by voicing, where unvoiced
+// kana precede voiced and semi-voiced kana, then by size, where small kana
+// precede large kana, and finally by script, where hiragana precede katakana
+// and vowel kana precede prolonged sound marks. Spaces and symbols precede
+// digits, which precede latin letters, which precede kana, which precede
+// kanji. Kana may instead be ordered in iroha order by setting Order. The
+// zero value is ready to use.
+type Collator struct {
+	// Order is the order of the kana, which is gojūon order by default.
+	Order KanaOrder
+}
+
+// KanaOrder indicates the order in which a Collator orders kana.
+type KanaOrder uint8
+
+const (
+	// OrderGojuon orders kana in gojūon order (あいうえお, かきくけこ…).
+	OrderGojuon KanaOrder = iota
+
+	// OrderIroha orders kana in the order of the iroha poem (いろはにほへと…),
+	// with ん following す.
+	OrderIroha
+)
+
+// collation classes, which order characters of different types at the
+// primary level.
+const (
+	collateSymbol uint32 = iota + 1
+	collateDigit
+	collateLatin
+	collateKana
+	collateKanji
+)
+
+// collation weights for the voicing, size and script levels. Characters
+// other than kana are weighted as unvoiced, large, and lowercase or
+// hiragana.
+const (
+	collateUnvoiced   byte = 1
+	collateVoiced     byte = 2
+	collateSemivoiced byte = 3
+
+	collateSmall byte = 1
+	collateLarge byte = 2
+
+	collateHiragana byte = 1
+	collateKatakana byte = 2
+	collateChoonpu  byte = 3
+	collateUpper    byte = 2
+)
+
+// gojuonIndex and irohaIndex map each unvoiced full size hiragana to its
+// position in gojūon order and in iroha order.
+var (
+	gojuonIndex = buildKanaIndex(gojuonOrder)
+	irohaIndex  = buildKanaIndex(irohaOrder + "ん")
+)
+
+// buildKanaIndex builds a lookup of the positions of each kana in order.
+func buildKanaIndex(order string) map[rune]uint32 {
+	m := map[rune]uint32{}
+	for i, r := range []rune(order) {
+		m[r] = uint32(i + 1)
+	}
+	return m
+}
+
+// Compare returns an integer comparing two strings in the collator's order. The
+// result will be 0 if a and b collate equally, -1 if a precedes b, and +1 if
+// b precedes a.
+func (c Collator) Compare(a, b string) int {
+	return bytes.Compare(c.SortKey(a), c.SortKey(b))
+}
+
+// Sort sorts a slice of strings in the collator's order.
+func (c Collator) Sort(s []string) {
+	keys := make(map[string][]byte, len(s))
+	for _, v := range s {
+		keys[v] = c.SortKey(v)
+	}
+
+	sort.SliceStable(s, func(i, j int) bool {
+		return bytes.Compare(keys[s[i]], keys[s[j]]) < 0
+	})
+}
+
+// SortKey returns a binary sort key for a string, such that comparing the
+// sort keys of two strings with bytes.Compare orders them as Compare does.
+// Sort keys may be stored for indexing in databases.
+func (c Collator) SortKey(s string) []byte {
+	in := []rune(ExpandIterationMarks(ToModernKana(normalizeWidth(s))))
+	primary := make([]byte, 0, len(in)*4+4)
+	voicing := make([]byte, 0, len(in)+1)
+	size := make([]byte, 0, len(in)+1)
+	script := make([]byte, 0, len(in)+1)
+
+	var w [4]byte
+	var vowel rune // the vowel of the preceding kana, for prolonged sound marks.
+	for _, r := range in {
+		p, v, sz, sc := c.weights(r, vowel)
+		if r != 'ー' {
+			vowel, _ = kanaVowel(r)
+		}
+		binary.BigEndian.PutUint32(w[:], p)
+		primary = append(primary, w[:]...)
+		voicing = append(voicing, v)
+		size = append(size, sz)
+		script = append(script, sc)
+	}
+
+	key := append(primary, 0, 0, 0, 0)
+	key = append(append(key, voicing...), 0)
+	key = append(append(key, size...), 0)
+	return append(key, script...)
+}
+
+// index returns the lookup of kana positions for the order of the collator.
+func (c Collator) index() map[rune]uint32 {
+	if c.Order == OrderIroha {
+		return irohaIndex
+	}
+	return gojuonIndex
+}
+
+// weights returns the primary, voicing, size and script weights of a
+// character, where vowel is the vowel of the preceding kana, if any.
+func (c Collator) weights(r rune, vowel rune) (uint32, byte, byte, byte) {
+	if r == 'ー' && vowel != 0 {
+		return collateKana<<24 | c.index()[vowel], collateUnvoiced, collateLarge, collateChoonpu
+	}
+
+	switch {
+	case unicode.In(r, unicode.Hiragana, unicode.Katakana) && r != 'ー' && r != '・':
+		return c.kanaWeights(r)
+	case r >= '0' && r <= '9':
+		return collateDigit<<24 | uint32(r), collateUnvoiced, collateLarge, collateHiragana
+	case unicode.IsUpper(r) && unicode.In(r, unicode.Latin):
+		return collateLatin<<24 | uint32(unicode.ToLower(r)), collateUnvoiced, collateLarge, collateUpper
+	case unicode.In(r, unicode.Latin):
+		return collateLatin<<24 | uint32(r), collateUnvoiced, collateLarge, collateHiragana
+	case unicode.In(r, kanjiTable) || strings.ContainsRune(kanjiMarks, r) || unicode.IsLetter(r):
+		return collateKanji<<24 | uint32(r), collateUnvoiced, collateLarge, collateHiragana
+	}
+
+	return collateSymbol<<24 | uint32(r), collateUnvoiced, collateLarge, collateHiragana
+}
+
+// kanaWeights returns the primary, voicing, size and script weights of a
+// kana.
+func (c Collator) kanaWeights(r rune) (uint32, byte, byte, byte) {
+	script := collateHiragana
+	if unicode.In(r, unicode.Katakana) {
+		script = collateKatakana
+	}
+
+	voicing := collateUnvoiced
+	if u, ok := unvoicedKana[r]; ok {
+		voicing = collateVoiced
+		if semivoicedKana[u] == r {
+			voicing = collateSemivoiced
+		}
+		r = u
+	}
+
+	size := collateLarge
+	if l, ok := smallKana[r]; ok {
+		size = collateSmall
+		r = l
+	}
+
+	i, ok := c.index()[KatakanaToHiragana(r)]
+	if !ok {
+		i = uint32(r) // kana outside the gojūon, which follow ん.
+	}
+
+	return collateKana<<24 | i, voicing, size, script
+}
@@ -0,0 +1,52 @@
+package kana
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollatorCompare(t *testing.T) {
+	tt := []struct {
+		a string
+		b string
+		r int
+	}{
+		{"あ", "い", -1},
+		{"か", "あ", 1},
+		{"あ", "ア", -1},    // hiragana before katakana
+		{"あい", "アイ", -1},  // hiragana before katakana
+		{"アカ", "あき", -1},  // script is only a tiebreaker
+		{"はは", "ばば", -1},  // unvoiced before voiced
+		{"ばば", "ぱぱ", -1},  // voiced before semi-voiced
+		{"ぱ", "はい", -1},   // voicing is only a tiebreaker
+		{"きや", "きゃ", 1},   // small before large
+		{"きゃ", "きゆ", -1},  // size is only a tiebreaker
+		{"カード", "カアド", 1}, // vowel before choonpu
+		{"カード", "カイ", -1}, // choonpu resolved to preceding vowel
+		{"ｶｰﾄﾞ", "カード", 0},
+		{"こゝろ", "こころ", 0},
+		{"あ", "あ", 0},
+		{"あ", "ああ", -1},
+		{"1", "a", -1},
+		{"a", "あ", -1},
+		{"あ", "亜", -1},
+		{"a", "A", -1},
+		{"Ａ", "A", 0},
+		{" ", "1", -1},
+		{"", "あ", -1},
+	}
+
+	c := Collator{}
+	for i, v := range tt {
+		require.Equal(t, v.r, c.Compare(v.a, v.b), "testing (%d) %s %s = %v", i, v.a, v.b, v.r)
+		require.Equal(t, v.r, bytes.Compare(c.SortKey(v.a), c.SortKey(v.b)), "testing (%d) %s %s = %v", i, v.a, v.b, v.r)
+	}
+}
+
+func TestCollatorSort(t *testing.T) {
+	s := []string{"ラーメン", "すし", "カレー", "かれい", "がっこう", "かっこう", "かつこう", "アイス", "あいす", "ぱん", "はん", "ばん"}
+	Collator{}.Sort(s)
+	require.Equal(t, []string{"あいす", "アイス", "かっこう", "かつこう", "がっこう", "かれい", "カレー", "すし", "はん", "ばん", "ぱん", "ラーメン"}, s)
+}
@@ -0,0 +1,126 @@
+package kana
+
+import (
+	"unicode"
+)
+
+// Composition contains the number of characters of each script found in a
+// string, as returned by ScriptComposition. Whitespace is counted separately
+// and is not included in the Total.
+type Composition struct {
+	Hiragana    int // hiragana characters, including small hiragana.
+	Katakana    int // katakana characters, including half-width katakana.
+	Kanji       int // kanji characters and kanji marks.
+	Choonpu     int // prolonged sound marks (ー).
+	Latin       int // latin letters, including full-width latin.
+	Digit       int // arabic numerals, including full-width numerals.
+	Punctuation int // Japanese and ASCII punctuation.
+	Other       int // all other characters.
+	Space       int // whitespace characters.
+	Total       int // the total number of non-whitespace characters.
+}
+
+// ScriptComposition counts the characters of each script found in a string,
+// using the classifications of Classify.
+func ScriptComposition(s string) Composition {
+	var c Composition
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			c.Space++
+			continue
+		}
+
+		c.Total++
+		t := Classify(r)
+		switch {
+		case t.Is(ScriptHiragana):
+			c.Hiragana++
+		case t.Is(ScriptKatakana):
+			c.Katakana++
+		case t.Is(ScriptKanji | ScriptKanjiMark):
+			c.Kanji++
+		case t.Is(ScriptChoonpu):
+			c.Choonpu++
+		case t.Is(ScriptLatin):
+			c.Latin++
+		case t.Is(ScriptDigit):
+			c.Digit++
+		case t.Is(ScriptPunctuation) || unicode.IsPunct(r):
+			c.Punctuation++
+		default:
+			c.Other++
+		}
+	}
+
+	return c
+}
+
+// Ratio returns the proportion of non-whitespace characters which match any
+// of the script flags of t, between 0 and 1. Kanji marks are counted as
+// kanji, and ASCII punctuation as punctuation. ScriptOther returns the
+// proportion of unclassified characters.
+func (c Composition) Ratio(t Script) float64 {
+	if c.Total == 0 {
+		return 0
+	}
+
+	n := 0
+	if t == ScriptOther {
+		n = c.Other
+	}
+	if t.Is(ScriptHiragana) {
+		n += c.Hiragana
+	}
+	if t.Is(ScriptKatakana) {
+		n += c.Katakana
+	}
+	if t.Is(ScriptKanji | ScriptKanjiMark) {
+		n += c.Kanji
+	}
+	if t.Is(ScriptChoonpu) {
+		n += c.Choonpu
+	}
+	if t.Is(ScriptLatin) {
+		n += c.Latin
+	}
+	if t.Is(ScriptDigit) {
+		n += c.Digit
+	}
+	if t.Is(ScriptPunctuation) {
+		n += c.Punctuation
+	}
+
+	return float64(n) / float64(c.Total)
+}
+
+// kanjiOnlyWeight is the weight of kanji in a JapaneseScore when no kana are
+// present, which is below the threshold of LooksJapanese.
+const kanjiOnlyWeight = 0.4
+
+// JapaneseScore returns a heuristic score between 0 and 1 indicating how
+// likely the counted string is to be Japanese text. The score is the
+// proportion of letters (excluding digits and punctuation) which are kana or
+// kanji. As kanji alone may indicate Chinese text, kanji are given a weight
+// of kanjiOnlyWeight if no kana are present, so that text written only in
+// kanji never looks Japanese to LooksJapanese.
+func (c Composition) JapaneseScore() float64 {
+	letters := c.Hiragana + c.Katakana + c.Kanji + c.Choonpu + c.Latin + c.Other
+	if letters == 0 {
+		return 0
+	}
+
+	kana := c.Hiragana + c.Katakana + c.Choonpu
+	kanji := float64(c.Kanji)
+	if kana == 0 {
+		kanji *= kanjiOnlyWeight
+	}
+
+	return (float64(kana) + kanji) / float64(letters)
+}
+
+// LooksJapanese returns true if a string is more likely than not to be
+// Japanese text, according to the JapaneseScore of its composition. Text
+// without kana, such as Chinese text, never looks Japanese.
+func LooksJapanese(s string) bool {
+	return ScriptComposition(s).JapaneseScore() >= 0.5
+}
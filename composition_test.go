@@ -0,0 +1,69 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptComposition(t *testing.T) {
+	tt := []struct {
+		s string
+		r Composition
+	}{
+		{"ひらがな", Composition{Hiragana: 4, Total: 4}},
+		{"カタカナ", Composition{Katakana: 4, Total: 4}},
+		{"ラーメン", Composition{Katakana: 3, Choonpu: 1, Total: 4}},
+		{"人々", Composition{Kanji: 2, Total: 2}},
+		{"食べる abc 123!", Composition{Hiragana: 2, Kanji: 1, Latin: 3, Digit: 3, Punctuation: 1, Space: 2, Total: 10}},
+		{"「ｶﾀｶﾅ」。", Composition{Katakana: 4, Punctuation: 3, Total: 7}},
+		{"ＡＢＣ１２", Composition{Latin: 3, Digit: 2, Total: 5}},
+		{"😀", Composition{Other: 1, Total: 1}},
+		{"", Composition{}},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ScriptComposition(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestCompositionRatio(t *testing.T) {
+	c := ScriptComposition("食べるabc!")
+	require.InDelta(t, 2.0/7, c.Ratio(ScriptHiragana), 0.0001)
+	require.InDelta(t, 1.0/7, c.Ratio(ScriptKanji), 0.0001)
+	require.InDelta(t, 3.0/7, c.Ratio(ScriptHiragana|ScriptKanji), 0.0001)
+	require.InDelta(t, 3.0/7, c.Ratio(ScriptLatin), 0.0001)
+	require.InDelta(t, 1.0/7, c.Ratio(ScriptPunctuation), 0.0001)
+	require.InDelta(t, 0, c.Ratio(ScriptOther), 0.0001)
+	require.InDelta(t, 0, ScriptComposition("").Ratio(ScriptHiragana), 0.0001)
+}
+
+func TestJapaneseScore(t *testing.T) {
+	tt := []struct {
+		s string
+		r float64
+	}{
+		{"ひらがな", 1},
+		{"日本語を話します。", 1},
+		{"水", 0.4},     // kanji alone could be Chinese
+		{"我爱你中国", 0.4}, // chinese
+		{"hello", 0},
+		{"hello ラーメン", 4.0 / 9},
+		{"123!", 0}, // digits and punctuation are neutral
+		{"", 0},
+	}
+
+	for i, v := range tt {
+		require.InDelta(t, v.r, ScriptComposition(v.s).JapaneseScore(), 0.0001, "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestLooksJapanese(t *testing.T) {
+	require.Equal(t, true, LooksJapanese("また、平易な日本語で伝える週刊ニュースも放送します。"))
+	require.Equal(t, false, LooksJapanese("水"))
+	require.Equal(t, false, LooksJapanese("我爱你中国"))
+	require.Equal(t, false, LooksJapanese("我爱你，中国！"))
+	require.Equal(t, true, LooksJapanese("iPhoneを買いました"))
+	require.Equal(t, false, LooksJapanese("This is English, with one word: 日本"))
+	require.Equal(t, false, LooksJapanese("12345"))
+}
@@ -5,7 +5,6 @@ import (
 	"reflect"
 	"strings"
 	"sync"
-	"unicode"
 	"unsafe"
 )
 
@@ -37,7 +36,11 @@ func unsafeString(buf *bytes.Buffer) (ret string) {
 
 // ToRomajiCased converts hiragana and/or katakana to cased romaji, where
 // hiragana and katakana are presented in lowercase and uppercase respectively.
+// Any hentaigana or extended kana are replaced with their modern equivalents,
+// and any kana iteration marks are expanded, before conversion. Kanji and
+// kanji iteration marks (々, 〻) are left unchanged.
 func ToRomajiCased(s string, phonetic bool) string {
+	s = expandKanaIterationMarks(ToModernKana(s))
 	a, b := pool.Get().(*bytes.Buffer), pool.Get().(*bytes.Buffer)
 	defer pool.Put(a)
 	defer pool.Put(b)
@@ -140,94 +143,55 @@ func KatakanaToHiragana(r rune) rune {
 // IsKatakana returns true if every element of a string is katakana, except
 // for characters indicated in sanitizeIsChecks (spaces and dashes).
 func IsKatakana(s string) bool {
-	s = sanitizeIsChecks.Replace(s)
-	if s == "" {
-		return false
-	}
-
-	for _, r := range s {
-		if !unicode.In(r, unicode.Katakana) {
-			return false
-		}
-	}
-
-	return true
+	ok, _, _ := IsKatakanaWith(s, sanitizeIsChecks)
+	return ok
 }
 
 // IsHiragana returns true if every element of a string is hiragana, except
 // for characters indicated in sanitizeIsChecks (spaces and dashes).
 func IsHiragana(s string) bool {
-	s = sanitizeIsChecks.Replace(s)
-	if s == "" {
-		return false
-	}
-
-	for _, r := range s {
-		if !unicode.In(r, unicode.Hiragana) {
-			return false
-		}
-	}
-
-	return true
+	ok, _, _ := IsHiraganaWith(s, sanitizeIsChecks)
+	return ok
 }
 
-// IsKanji returns true if every element of a string is a kanji character,
+// IsKanji returns true if every element of a string is a kanji character
+// under the KanjiDefault policy, including the kanji marks (々, 〆, 〇, 〻),
 // except for characters indicated in sanitizeIsChecksKanji (spaces).
 func IsKanji(s string) bool {
-	s = sanitizeIsChecksKanji.Replace(s)
-	if s == "" {
-		return false
-	}
-
-	for _, r := range s {
-		if !unicode.In(r, unicode.Han) {
-			return false
-		}
-	}
-
-	return true
+	ok, _, _ := IsKanjiWith(s, sanitizeIsChecksKanji)
+	return ok
 }
 
 // ContainsKatakana returns true if a string contains any katakana characters.
 func ContainsKatakana(s string) bool {
-	for _, r := range s {
-		if unicode.In(r, unicode.Katakana) {
-			return true
-		}
-	}
-
-	return false
+	return containsAny(s, ScriptKatakana)
 }
 
 // ContainsHiragana returns true if a string contains any hiragana characters.
 func ContainsHiragana(s string) bool {
-	for _, r := range s {
-		if unicode.In(r, unicode.Hiragana) {
-			return true
-		}
-	}
-
-	return false
+	return containsAny(s, ScriptHiragana)
 }
 
-// ContainsKanji returns true if a string contains any kanji characters.
+// ContainsKanji returns true if a string contains any kanji characters under
+// the KanjiDefault policy, including the kanji marks (々, 〆, 〇, 〻).
 func ContainsKanji(s string) bool {
 	for _, r := range s {
-		if unicode.In(r, unicode.Han) {
+		if IsKanjiRune(r, KanjiDefault) {
 			return true
 		}
 	}
-
 	return false
 }
 
 // ExtractKanji returns a slice containing all kanji characters found in a
 // string, in the order in which they were found. If a kanji exists multiple
-// times in a string, then each instance of the kanji will be returned.
+// times in a string, then each instance of the kanji will be returned. Kanji
+// are found under the KanjiDefault policy, including the kanji marks (々, 〆,
+// 〇, 〻).
 func ExtractKanji(s string) []string {
 	k := []string{}
 	for _, r := range s {
-		if unicode.In(r, unicode.Han) {
+		if IsKanjiRune(r, KanjiDefault) {
 			k = append(k, string(r))
 		}
 	}
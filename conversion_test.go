@@ -191,6 +191,8 @@ func TestIsKanji(t *testing.T) {
 		{"水カタひら", false},    // mixed kanji, katakana and hiragana
 		{"水abcひらがな", false}, // mixed kanji, latin and hiragana
 		{"水abcカタカナ", false}, // mixed kanji, latin and katakana
+		{"人々", true},        // iteration marks are permitted
+		{"ー", false},        // prolonged sound marks are not kanji
 		{"", false},         // empty string
 		{" 　 ", false},      // just spaces
 	}
@@ -260,6 +262,8 @@ func TestContainsKanji(t *testing.T) {
 		{"水カタひら", true},    // mixed kanji, katakana and hiragana
 		{"水abcひらがな", true}, // mixed kanji, latin and hiragana
 		{"水abcカタカナ", true}, // mixed kanji, latin and katakana
+		{"ラーメン", false},    // prolonged sound marks are not kanji
+		{"々", true},        // iteration marks are kanji
 		{"", false},        // empty string
 		{" 　 ", false},     // just spaces
 	}
@@ -277,6 +281,11 @@ func TestExtractKanji(t *testing.T) {
 		{"食べる", []string{"食"}},
 		{"鉛筆削り", []string{"鉛", "筆", "削"}},
 		{"wakareru 分かれる ", []string{"分"}},
+		{"人々", []string{"人", "々"}}, // iteration marks are kanji
+		{"〆切", []string{"〆", "切"}}, // shime is a kanji mark
+		{"⼈", []string{}},          // radicals are not kanji
+		{"ラーメン", []string{}},       // prolonged sound marks are not kanji
+		{"𠮟る", []string{"𠮟"}},      // extension b
 		{"また、平易な日本語で伝える週刊ニュースも放送します。日本語", []string{"平", "易", "日", "本", "語", "伝", "週", "刊", "放", "送", "日", "本", "語"}},
 	}
 
@@ -1628,3 +1637,13 @@ func BenchmarkToKana(b *testing.B) {
 		ToKana("konnichiwa")
 	}
 }
+
+func TestKanjiDefaultPolicyIsConsistent(t *testing.T) {
+	for _, s := range []string{"々", "〆", "〇", "〻", "人", "⼈", "ー"} {
+		r := []rune(s)[0]
+		want := IsKanjiRune(r, KanjiDefault)
+		require.Equal(t, want, IsKanji(s), "testing IsKanji %s", s)
+		require.Equal(t, want, ContainsKanji(s), "testing ContainsKanji %s", s)
+		require.Equal(t, want, len(ExtractKanji(s)) == 1, "testing ExtractKanji %s", s)
+	}
+}
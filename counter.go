@@ -0,0 +1,103 @@
+package kana
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrUnknownCounter indicates a counter which is not known to
+	// CounterToKana.
+	ErrUnknownCounter = errors.New("unknown counter")
+
+	// ErrCounterRange indicates a number which cannot be read with a counter,
+	// such as a negative number.
+	ErrCounterRange = errors.New("number out of range for counter")
+)
+
+// Counter describes how a counter word is read following a number, as used
+// by CounterToKana.
+type Counter struct {
+	// Reading is the reading of the counter following a number which causes
+	// no sound change, such as ほん.
+	Reading string
+
+	// Endings maps the endings of number readings to their reading when
+	// combined with the counter, such as いち to いっぽん. The longest
+	// matching ending is used.
+	Endings map[string]string
+
+	// Numbers maps numbers to their full reading when combined with the
+	// counter, such as 1 to ひとり. Numbers take precedence over Endings.
+	Numbers map[int64]string
+
+	// Fallback is the counter used for numbers which are not found in
+	// Numbers, for counters which have no Reading, such as 個 for つ, which
+	// only counts to ten.
+	Fallback string
+}
+
+// RegisterCounter adds a counter to those known to CounterToKana, replacing
+// any existing counter written the same way. RegisterCounter is not safe to
+// call concurrently with CounterToKana.
+func RegisterCounter(counter string, c Counter) {
+	counters[counter] = c
+}
+
+// CounterToKana returns the reading in hiragana of a number followed by a
+// counter, such as いっぽん for 1 and 本, さんびき for 3 and 匹, ふつか for 2
+// and 日, or ひとり for 1 and 人. Sound changes follow the final place of the
+// number, such that 3000 and 本 is さんぜんぼん. Numbers which the counter
+// cannot read are read with its Fallback counter, such as じゅういっこ for
+// 11 and つ. The reading may be passed to ToRomaji. ErrCounterRange is
+// returned for negative numbers.
+func CounterToKana(n int64, counter string) (string, error) {
+	c, ok := counters[counter]
+	if !ok {
+		return "", ErrUnknownCounter
+	}
+
+	if n < 0 {
+		return "", ErrCounterRange
+	}
+
+	if r, ok := c.Numbers[n]; ok {
+		return r, nil
+	}
+
+	if c.Reading == "" {
+		if c.Fallback == "" || c.Fallback == counter {
+			return "", ErrCounterRange
+		}
+		return CounterToKana(n, c.Fallback)
+	}
+
+	num := NumberToKana(n, NumberReadingOptions{})
+	ending, suffix := "", ""
+	for e := range c.Endings {
+		if len(e) > len(suffix) && strings.HasSuffix(num, e) {
+			ending, suffix = e, e
+		}
+		for v, base := range numberUnitVariants {
+			if base == e && len(v) > len(suffix) && strings.HasSuffix(num, v) {
+				ending, suffix = e, v
+			}
+		}
+	}
+
+	if ending == "" {
+		return num + c.Reading, nil
+	}
+
+	r := c.Endings[ending]
+	if suffix != ending {
+		// The place was voiced by the preceding digit, as in さんびゃく, so
+		// the first kana of the ending is voiced in the same way.
+		_, size := utf8.DecodeRuneInString(suffix)
+		_, esize := utf8.DecodeRuneInString(r)
+		r = suffix[:size] + r[esize:]
+	}
+
+	return strings.TrimSuffix(num, suffix) + r, nil
+}
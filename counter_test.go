@@ -0,0 +1,99 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounterToKana(t *testing.T) {
+	tt := []struct {
+		n       int64
+		counter string
+		r       string
+		err     error
+	}{
+		{1, "本", "いっぽん", nil},
+		{2, "本", "にほん", nil},
+		{3, "本", "さんぼん", nil},
+		{4, "本", "よんほん", nil},
+		{6, "本", "ろっぽん", nil},
+		{8, "本", "はっぽん", nil},
+		{10, "本", "じゅっぽん", nil},
+		{11, "本", "じゅういっぽん", nil},
+		{100, "本", "ひゃっぽん", nil},
+		{1000, "本", "せんぼん", nil},
+		{3, "匹", "さんびき", nil},
+		{1, "匹", "いっぴき", nil},
+		{1, "杯", "いっぱい", nil},
+		{3, "杯", "さんばい", nil},
+		{3, "分", "さんぷん", nil},
+		{4, "分", "よんぷん", nil},
+		{5, "分", "ごふん", nil},
+		{1, "人", "ひとり", nil},
+		{2, "人", "ふたり", nil},
+		{3, "人", "さんにん", nil},
+		{4, "人", "よにん", nil},
+		{14, "人", "じゅうよにん", nil},
+		{1, "日", "ついたち", nil},
+		{2, "日", "ふつか", nil},
+		{11, "日", "じゅういちにち", nil},
+		{14, "日", "じゅうよっか", nil},
+		{17, "日", "じゅうしちにち", nil},
+		{20, "日", "はつか", nil},
+		{24, "日", "にじゅうよっか", nil},
+		{1, "つ", "ひとつ", nil},
+		{9, "つ", "ここのつ", nil},
+		{10, "つ", "とお", nil},
+		{1, "個", "いっこ", nil},
+		{6, "個", "ろっこ", nil},
+		{1, "回", "いっかい", nil},
+		{3, "回", "さんかい", nil},
+		{3, "階", "さんがい", nil},
+		{20, "歳", "はたち", nil},
+		{8, "歳", "はっさい", nil},
+		{9, "時", "くじ", nil},
+		{4, "時", "よじ", nil},
+		{3, "枚", "さんまい", nil},
+		{3, "羽", "", ErrUnknownCounter},
+		{3000, "本", "さんぜんぼん", nil},
+		{8000, "本", "はっせんぼん", nil},
+		{300, "本", "さんびゃっぽん", nil},
+		{600, "本", "ろっぴゃっぽん", nil},
+		{800, "匹", "はっぴゃっぴき", nil},
+		{3000, "分", "さんぜんぷん", nil},
+		{10000, "分", "いちまんぷん", nil},
+		{3000, "枚", "さんぜんまい", nil},
+		{11, "つ", "じゅういっこ", nil},
+		{100, "つ", "ひゃっこ", nil},
+		{0, "本", "ぜろほん", nil},
+		{0, "つ", "ぜろこ", nil},
+		{-1, "本", "", ErrCounterRange},
+		{-3, "つ", "", ErrCounterRange},
+	}
+
+	for i, v := range tt {
+		r, err := CounterToKana(v.n, v.counter)
+		require.Equal(t, v.err, err, "testing (%d) %d%s", i, v.n, v.counter)
+		require.Equal(t, v.r, r, "testing (%d) %d%s = %v", i, v.n, v.counter, v.r)
+	}
+}
+
+func TestRegisterCounter(t *testing.T) {
+	RegisterCounter("羽", Counter{Reading: "わ", Endings: map[string]string{"さん": "さんば", "ろく": "ろっぱ"}})
+	defer delete(counters, "羽")
+
+	r, err := CounterToKana(3, "羽")
+	require.NoError(t, err)
+	require.Equal(t, "さんば", r)
+
+	r, err = CounterToKana(2, "羽")
+	require.NoError(t, err)
+	require.Equal(t, "にわ", r)
+}
+
+func TestCounterToKanaRomaji(t *testing.T) {
+	r, err := CounterToKana(1, "本")
+	require.NoError(t, err)
+	require.Equal(t, "ippon", ToRomaji(r, false))
+}
@@ -0,0 +1,147 @@
+package kana
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidDictionary indicates a dictionary file which could not be parsed.
+var ErrInvalidDictionary = errors.New("invalid dictionary")
+
+// Dictionary looks up the readings of words written with kanji, such that
+// ToRomajiWith may read kanji which ToRomaji would otherwise leave unchanged.
+type Dictionary interface {
+	// Lookup returns the reading of the longest word at the beginning of s,
+	// and the length of the word in bytes. The length is 0 if no word at the
+	// beginning of s is known.
+	Lookup(s string) (reading string, n int)
+}
+
+// MemoryDictionary is an in-memory Dictionary of words and their readings.
+// The zero value is an empty dictionary ready to use.
+type MemoryDictionary struct {
+	words    map[string]string
+	priority map[string]int // the priority of words added from dictionary entries.
+	maxLen   int            // the length of the longest word in bytes.
+}
+
+// NewMemoryDictionary returns a MemoryDictionary containing the words and
+// readings of a map, keyed by word.
+func NewMemoryDictionary(words map[string]string) *MemoryDictionary {
+	d := &MemoryDictionary{}
+	for w, r := range words {
+		d.Add(w, r)
+	}
+	return d
+}
+
+// LoadDictionary returns a MemoryDictionary read from tab-separated values,
+// as Load.
+func LoadDictionary(r io.Reader) (*MemoryDictionary, error) {
+	d := &MemoryDictionary{}
+	if err := d.Load(r); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Add adds a word and its reading to the dictionary, replacing any reading
+// already held for the word. Words added with Add take precedence over words
+// added from dictionary entries with AddEntry.
+func (d *MemoryDictionary) Add(word, reading string) {
+	if word == "" {
+		return
+	}
+
+	if d.words == nil {
+		d.words = map[string]string{}
+	}
+
+	d.words[word] = reading
+	delete(d.priority, word)
+	if len(word) > d.maxLen {
+		d.maxLen = len(word)
+	}
+}
+
+// Load adds the words and readings read from tab-separated values, with one
+// word per line followed by its reading, as in 東京	とうきょう. Any further
+// columns are ignored, as are blank lines and lines beginning with #.
+func (d *MemoryDictionary) Load(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+
+		cols := strings.Split(s, "\t")
+		if len(cols) < 2 || cols[0] == "" || strings.TrimSpace(cols[1]) == "" {
+			return fmt.Errorf("%w: line %d", ErrInvalidDictionary, line)
+		}
+		d.Add(strings.TrimSpace(cols[0]), strings.TrimSpace(cols[1]))
+	}
+
+	return sc.Err()
+}
+
+// Len returns the number of words in the dictionary.
+func (d *MemoryDictionary) Len() int {
+	return len(d.words)
+}
+
+// Lookup returns the reading of the longest word in the dictionary at the
+// beginning of s, and the length of the word in bytes.
+func (d *MemoryDictionary) Lookup(s string) (string, int) {
+	n := len(s)
+	if n > d.maxLen {
+		n = d.maxLen
+	}
+
+	for ; n > 0; n-- {
+		if n < len(s) && !utf8.RuneStart(s[n]) {
+			continue // not a rune boundary.
+		}
+		if r, ok := d.words[s[:n]]; ok {
+			return r, n
+		}
+	}
+
+	return "", 0
+}
+
+// ReplaceReadings replaces each word in a string which contains kanji and is
+// known to the dictionary with its reading, preferring the longest word at
+// each position, such that 東京タワー becomes とうきょうタワー.
+func ReplaceReadings(s string, d Dictionary) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		if r, n := d.Lookup(s[i:]); n > 0 && ContainsKanji(s[i:i+n]) {
+			sb.WriteString(r)
+			i += n
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		sb.WriteString(s[i : i+size])
+		i += size
+	}
+
+	return sb.String()
+}
+
+// ToRomajiWith converts a string to lowercase romaji as ToRomaji, after
+// replacing the words known to the dictionary with their readings.
+func ToRomajiWith(s string, phonetic bool, d Dictionary) string {
+	return ToRomaji(ReplaceReadings(s, d), phonetic)
+}
+
+// ToRomajiCasedWith converts a string to cased romaji as ToRomajiCased,
+// after replacing the words known to the dictionary with their readings.
+func ToRomajiCasedWith(s string, phonetic bool, d Dictionary) string {
+	return ToRomajiCased(ReplaceReadings(s, d), phonetic)
+}
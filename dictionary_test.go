@@ -0,0 +1,95 @@
+package kana
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDictionaryTSV = `# word	reading
+東京	とうきょう
+東	ひがし
+京都	きょうと
+お茶	おちゃ
+日本語	にほんご
+日本	にほん
+
+大学	だいがく	noun
+`
+
+func TestLoadDictionary(t *testing.T) {
+	d, err := LoadDictionary(strings.NewReader(testDictionaryTSV))
+	require.NoError(t, err)
+	require.Equal(t, 7, d.Len())
+
+	_, err = LoadDictionary(strings.NewReader("東京\n"))
+	require.True(t, errors.Is(err, ErrInvalidDictionary))
+	require.Contains(t, err.Error(), "line 1")
+
+	_, err = LoadDictionary(strings.NewReader("東京	とうきょう\n京都	 \n"))
+	require.True(t, errors.Is(err, ErrInvalidDictionary))
+	require.Contains(t, err.Error(), "line 2")
+}
+
+func TestMemoryDictionaryLookup(t *testing.T) {
+	d, err := LoadDictionary(strings.NewReader(testDictionaryTSV))
+	require.NoError(t, err)
+
+	tt := []struct {
+		s string
+		r string
+		n int
+	}{
+		{"東京タワー", "とうきょう", 6},
+		{"東", "ひがし", 3},
+		{"東へ", "ひがし", 3},
+		{"日本語です", "にほんご", 9},
+		{"日本です", "にほん", 6},
+		{"大阪", "", 0},
+		{"", "", 0},
+	}
+
+	for i, v := range tt {
+		r, n := d.Lookup(v.s)
+		require.Equal(t, v.r, r, "testing (%d) %s = %v", i, v.s, v.r)
+		require.Equal(t, v.n, n, "testing (%d) %s = %v", i, v.s, v.n)
+	}
+
+	var empty MemoryDictionary
+	r, n := empty.Lookup("東京")
+	require.Equal(t, "", r)
+	require.Equal(t, 0, n)
+}
+
+func TestToRomajiWith(t *testing.T) {
+	d := NewMemoryDictionary(map[string]string{
+		"東京":  "とうきょう",
+		"京都":  "きょうと",
+		"お茶":  "おちゃ",
+		"日本語": "にほんご",
+		"日本":  "にほん",
+		"大学":  "だいがく",
+		"です":  "でした",
+	})
+
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"東京タワー", "toukyoutawa-"},
+		{"東京と京都", "toukyoutokyouto"},
+		{"お茶です", "ochadesu"},
+		{"日本語の大学", "nihongonodaigaku"},
+		{"日本の大阪", "nihonno大阪"},
+		{"とうきょう", "toukyou"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ToRomajiWith(v.s, false, d), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+
+	require.Equal(t, "toukyouTAWA-", ToRomajiCasedWith("東京タワー", false, d))
+	require.Equal(t, "日本の大阪", ReplaceReadings("日本の大阪", &MemoryDictionary{}))
+}
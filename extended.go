@@ -0,0 +1,63 @@
+package kana
+
+import (
+	"strings"
+)
+
+// ToModernKana replaces hentaigana, archaic kana, extended small kana and
+// katakana phonetic extensions with their modern kana equivalents, such that
+// 𛀂 (HENTAIGANA LETTER A-1) becomes あ and ㇰ becomes ク. All other characters
+// are preserved.
+func ToModernKana(s string) string {
+	if !containsExtendedKana(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if k, ok := modernKana(r); ok {
+			b.WriteString(k)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// IsHentaigana returns true if a rune is a hentaigana character.
+func IsHentaigana(r rune) bool {
+	return r >= hentaigana[0].lo && r <= hentaigana[len(hentaigana)-1].hi
+}
+
+// modernKana returns the modern kana equivalent of an extended kana rune.
+func modernKana(r rune) (string, bool) {
+	if k, ok := extendedKana[r]; ok {
+		return k, true
+	}
+
+	if IsHentaigana(r) {
+		for _, h := range hentaigana {
+			if r >= h.lo && r <= h.hi {
+				return h.kana, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// containsExtendedKana returns true if a string contains any characters which
+// may be replaced by ToModernKana.
+func containsExtendedKana(s string) bool {
+	for _, r := range s {
+		if r >= 'ㇰ' {
+			if _, ok := modernKana(r); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
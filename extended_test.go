@@ -0,0 +1,63 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToModernKana(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"\U0001B002", "あ"},  // hentaigana a-1
+		{"\U0001B005", "あ"},  // hentaigana a-wo uses first reading
+		{"\U0001B022", "か"},  // hentaigana ka-ke uses first reading
+		{"\U0001B09E", "は"},  // hentaigana ha-1
+		{"\U0001B11D", "ん"},  // hentaigana n-mu-mo-1
+		{"\U0001B001", "いぇ"}, // archaic ye
+		{"\U0001B000", "エ"},  // archaic katakana e
+		{"\U0001B150", "ゐ"},  // small wi
+		{"\U0001B167", "ン"},  // katakana small n
+		{"ㇰㇱ", "クシ"},         // katakana phonetic extensions
+		{"ひらがな", "ひらがな"},     // modern kana unchanged
+		{"abc 水", "abc 水"},   // non-kana unchanged
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ToModernKana(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestIsHentaigana(t *testing.T) {
+	require.Equal(t, true, IsHentaigana(0x1B002))
+	require.Equal(t, true, IsHentaigana(0x1B11E))
+	require.Equal(t, false, IsHentaigana(0x1B001))
+	require.Equal(t, false, IsHentaigana('あ'))
+}
+
+func TestExtendedKanaDetection(t *testing.T) {
+	require.Equal(t, true, IsHiragana("\U0001B002\U0001B017"))
+	require.Equal(t, true, IsHiragana("こ\U0001B132"))
+	require.Equal(t, true, IsKatakana("ㇰㇱ"))
+	require.Equal(t, true, IsKatakana("\U0001B000\U0001B164"))
+	require.Equal(t, false, IsKatakana("\U0001B002"))
+	require.Equal(t, true, ContainsHiragana("abc\U0001B002"))
+	require.Equal(t, true, ContainsKatakana("abcㇰ"))
+}
+
+func TestToRomajiShouldConvertExtendedKana(t *testing.T) {
+	tt := [][]string{
+		{"\U0001B002\U0001B017", "aka"},
+		{"\U0001B001", "ye"},
+		{"\U0001B112", "we"},
+		{"ㇰ", "KU"},
+		{"\U0001B002ゝ", "aa"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v[1], ToRomajiCased(v[0], false), "testing (%d) %s = %s", i, v[0], v[1])
+	}
+}
@@ -0,0 +1,59 @@
+package kana
+
+import "strings"
+
+// FoldOptions contains options for folding strings with FoldWith and
+// EqualFoldWith.
+type FoldOptions struct {
+	// IgnoreDakuten folds voiced and semi-voiced kana to unvoiced kana, such
+	// that が, ぱ and ば fold as か and は.
+	IgnoreDakuten bool
+
+	// IgnoreSmall folds small kana to their full size equivalents, such that
+	// きゃ folds as きや.
+	IgnoreSmall bool
+}
+
+// Fold returns the canonical form of a string for script-insensitive
+// comparison, such that ひらがな, ヒラガナ, ﾋﾗｶﾞﾅ and hiragana all fold to
+// ひらがな. Half-width and full-width characters are normalized, voiced sound
+// marks are composed with their kana, hentaigana and iteration marks are
+// replaced, romaji and katakana are converted to hiragana, prolonged sound
+// marks (ー) are replaced with the vowel they extend, and any remaining latin
+// letters are lowercased.
+func Fold(s string) string {
+	return FoldWith(s, FoldOptions{})
+}
+
+// FoldWith returns the canonical form of a string in the same manner as
+// Fold, additionally folding voicing or size differences according to the
+// given options.
+func FoldWith(s string, opts FoldOptions) string {
+	s = ResolveChoonpu(ToHiragana(ExpandIterationMarks(ToModernKana(normalizeWidth(s)))))
+	s = strings.ToLower(s)
+	if !opts.IgnoreDakuten && !opts.IgnoreSmall {
+		return s
+	}
+
+	return strings.Map(func(r rune) rune {
+		if opts.IgnoreDakuten {
+			r = unvoiceKana(r)
+		}
+		if l, ok := smallKana[r]; ok && opts.IgnoreSmall {
+			r = l
+		}
+		return r
+	}, s)
+}
+
+// EqualFold returns true if two strings are equal once folded with Fold,
+// such that EqualFold("ひらがな", "hiragana") is true.
+func EqualFold(a, b string) bool {
+	return Fold(a) == Fold(b)
+}
+
+// EqualFoldWith returns true if two strings are equal once folded with
+// FoldWith using the given options.
+func EqualFoldWith(a, b string, opts FoldOptions) bool {
+	return FoldWith(a, opts) == FoldWith(b, opts)
+}
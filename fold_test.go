@@ -0,0 +1,72 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFold(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"ひらがな", "ひらがな"},
+		{"ヒラガナ", "ひらがな"},
+		{"ﾋﾗｶﾞﾅ", "ひらがな"},
+		{"hiragana", "ひらがな"},
+		{"HIRAGANA", "ひらがな"},
+		{"ラーメン", "らあめん"},
+		{"ｶｰﾄﾞ", "かあど"},
+		{"こゝろ", "こころ"},
+		{"が", "が"},
+		{"東京タワー", "東京たわあ"},
+		{"ＫＡＮＡ", "かな"},
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, Fold(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestFoldWith(t *testing.T) {
+	tt := []struct {
+		s    string
+		opts FoldOptions
+		r    string
+	}{
+		{"ガッコウ", FoldOptions{}, "がっこう"},
+		{"ガッコウ", FoldOptions{IgnoreDakuten: true}, "かっこう"},
+		{"ガッコウ", FoldOptions{IgnoreSmall: true}, "がつこう"},
+		{"ガッコウ", FoldOptions{IgnoreDakuten: true, IgnoreSmall: true}, "かつこう"},
+		{"パン", FoldOptions{IgnoreDakuten: true}, "はん"},
+		{"きゃ", FoldOptions{IgnoreSmall: true}, "きや"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, FoldWith(v.s, v.opts), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	tt := []struct {
+		a  string
+		b  string
+		ok bool
+	}{
+		{"ひらがな", "ヒラガナ", true},
+		{"ひらがな", "ﾋﾗｶﾞﾅ", true},
+		{"ひらがな", "hiragana", true},
+		{"カード", "かあど", true},
+		{"がっこう", "かっこう", false},
+		{"ひらがな", "かたかな", false},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.ok, EqualFold(v.a, v.b), "testing (%d) %s %s = %v", i, v.a, v.b, v.ok)
+	}
+
+	require.True(t, EqualFoldWith("がっこう", "カツコウ", FoldOptions{IgnoreDakuten: true, IgnoreSmall: true}))
+	require.False(t, EqualFoldWith("がっこう", "カツコウ", FoldOptions{IgnoreDakuten: true}))
+}
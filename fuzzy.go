@@ -0,0 +1,152 @@
+package kana
+
+import "strings"
+
+// Matcher matches strings against a query by their pronunciation, such that
+// the romaji queries tokyo, toukyou and tôkyô all match とうきょう and
+// トウキョウ. Strings are compared as sequences of morae, ignoring script,
+// width, vowel length, and spelling differences which are pronounced alike.
+type Matcher struct {
+	query []string
+}
+
+// NewMatcher returns a Matcher for a query in kana or romaji.
+func NewMatcher(query string) *Matcher {
+	return &Matcher{query: phoneticMorae(query)}
+}
+
+// Match returns true if a string is pronounced the same as the query.
+func (m *Matcher) Match(s string) bool {
+	return m.Distance(s) == 0
+}
+
+// MatchPrefix returns true if a string begins with the pronunciation of the
+// query.
+func (m *Matcher) MatchPrefix(s string) bool {
+	morae := phoneticMorae(s)
+	return len(morae) >= len(m.query) && moraeEqual(morae[:len(m.query)], m.query)
+}
+
+// MatchSubstring returns true if a string contains the pronunciation of the
+// query.
+func (m *Matcher) MatchSubstring(s string) bool {
+	morae := phoneticMorae(s)
+	for i := 0; i+len(m.query) <= len(morae); i++ {
+		if moraeEqual(morae[i:i+len(m.query)], m.query) {
+			return true
+		}
+	}
+	return false
+}
+
+// Distance returns the edit distance between the pronunciation of a string
+// and the query, measured in morae, such that tokio is a distance of 2 from
+// tokyo.
+func (m *Matcher) Distance(s string) int {
+	return moraeDistance(phoneticMorae(s), m.query)
+}
+
+// PhoneticKey returns a romaji key representing the pronunciation of a string
+// in kana or romaji, in the same manner as Matcher, such that とうきょう,
+// トーキョー, toukyou and tôkyô all have the key tokyo.
+func PhoneticKey(s string) string {
+	var sb strings.Builder
+	for _, m := range phoneticMorae(s) {
+		sb.WriteString(m)
+	}
+	return ToRomaji(sb.String(), true)
+}
+
+// MoraDistance returns the edit distance between the pronunciation of two
+// strings in kana or romaji, measured in morae.
+func MoraDistance(a, b string) int {
+	return moraeDistance(phoneticMorae(a), phoneticMorae(b))
+}
+
+// phoneticMorae splits a string into the hiragana morae it is pronounced as,
+// where small kana other than っ join the preceding mora, and っ is a mora of
+// its own. Morae which lengthen the vowel of the preceding mora are dropped,
+// along with spaces.
+func phoneticMorae(s string) []string {
+	s = Fold(longVowelRomaji.Replace(s))
+	morae := []string{}
+	var vowel rune
+	for _, r := range s {
+		if p, ok := phoneticKana[r]; ok {
+			r = p
+		}
+
+		n := len(morae)
+		switch {
+		case r == ' ':
+			vowel = 0
+			continue
+		case n > 0 && isSmallKana(r) && r != 'っ' && morae[n-1] != "っ":
+			morae[n-1] += string(r)
+		case n > 0 && isLongVowel(vowel, r):
+			continue
+		default:
+			morae = append(morae, string(r))
+		}
+
+		vowel, _ = kanaVowel(r)
+	}
+
+	return morae
+}
+
+// isLongVowel returns true if a vowel kana lengthens a preceding mora ending
+// in vowel, as in ああ, いい, うう, ええ, えい, おお and おう.
+func isLongVowel(vowel, r rune) bool {
+	switch r {
+	case 'あ', 'い', 'う', 'え', 'お':
+		return vowel == r || (vowel == 'え' && r == 'い') || (vowel == 'お' && r == 'う')
+	}
+	return false
+}
+
+// moraeEqual returns true if two sequences of morae are equal.
+func moraeEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// moraeDistance returns the Levenshtein distance between two sequences of
+// morae.
+func moraeDistance(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(minInt(prev[j]+1, cur[j-1]+1), prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+// minInt returns the smaller of two integers.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,102 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneticKey(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"とうきょう", "tokyo"},
+		{"トウキョウ", "tokyo"},
+		{"トーキョー", "tokyo"},
+		{"tokyo", "tokyo"},
+		{"toukyou", "tokyo"},
+		{"tōkyō", "tokyo"},
+		{"tôkyô", "tokyo"},
+		{"Tokyo", "tokyo"},
+		{"せんせい", "sense"},
+		{"おおさか", "osaka"},
+		{"ちぢみ", "chijimi"},
+		{"きって", "kitte"},
+		{"ｷｯﾃ", "kitte"},
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, PhoneticKey(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestMoraDistance(t *testing.T) {
+	tt := []struct {
+		a string
+		b string
+		r int
+	}{
+		{"tokyo", "とうきょう", 0},
+		{"tokio", "tokyo", 2},
+		{"kyoto", "きょうと", 0},
+		{"kyouto", "tokyo", 2},
+		{"さくら", "さから", 1},
+		{"さくら", "", 3},
+		{"きって", "", 3},
+		{"kitte", "kite", 1},
+		{"がっこう", "がこう", 1},
+		{"きって", "きっと", 1},
+		{"", "", 0},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, MoraDistance(v.a, v.b), "testing (%d) %s %s = %v", i, v.a, v.b, v.r)
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	tt := []struct {
+		query     string
+		s         string
+		match     bool
+		prefix    bool
+		substring bool
+		distance  int
+	}{
+		{"tokyo", "とうきょう", true, true, true, 0},
+		{"tôkyô", "トウキョウ", true, true, true, 0},
+		{"tokio", "とうきょう", false, false, false, 2},
+		{"tokyo", "とうきょうタワー", false, true, true, 2},
+		{"tawa", "とうきょうタワー", false, false, true, 2},
+		{"kyo", "とうきょう", false, false, true, 1},
+		{"osaka", "とうきょう", false, false, false, 3},
+	}
+
+	for i, v := range tt {
+		m := NewMatcher(v.query)
+		require.Equal(t, v.match, m.Match(v.s), "testing (%d) %s %s", i, v.query, v.s)
+		require.Equal(t, v.prefix, m.MatchPrefix(v.s), "testing (%d) %s %s", i, v.query, v.s)
+		require.Equal(t, v.substring, m.MatchSubstring(v.s), "testing (%d) %s %s", i, v.query, v.s)
+		require.Equal(t, v.distance, m.Distance(v.s), "testing (%d) %s %s", i, v.query, v.s)
+	}
+}
+
+func TestPhoneticMorae(t *testing.T) {
+	tt := []struct {
+		s string
+		r []string
+	}{
+		{"きって", []string{"き", "っ", "て"}},
+		{"kitte", []string{"き", "っ", "て"}},
+		{"がっこう", []string{"が", "っ", "こ"}},
+		{"きょうと", []string{"きょ", "と"}},
+		{"ちょっと", []string{"ちょ", "っ", "と"}},
+		{"", []string{}},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, phoneticMorae(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
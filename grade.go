@@ -0,0 +1,82 @@
+package kana
+
+import "strings"
+
+// KanjiGradeSecondary is the grade given to Jōyō kanji which are not Kyōiku
+// kanji, and which are taught in secondary school rather than in one of the
+// six grades of elementary school.
+const KanjiGradeSecondary = 7
+
+// kanjiGrades maps each Jōyō kanji to the grade in which it is taught.
+var kanjiGrades = buildKanjiGrades()
+
+// buildKanjiGrades builds the kanjiGrades lookup from the embedded lists.
+func buildKanjiGrades() map[rune]int {
+	m := make(map[rune]int, 2200)
+	for i, s := range kyoikuKanji {
+		for _, r := range s {
+			m[r] = i + 1
+		}
+	}
+	for _, r := range joyoSecondaryKanji {
+		m[r] = KanjiGradeSecondary
+	}
+	for r, official := range joyoKanjiAliases {
+		m[r] = m[official]
+	}
+
+	return m
+}
+
+// KanjiGrade returns the elementary school grade (1 to 6) in which a Kyōiku
+// kanji is taught, KanjiGradeSecondary for the remaining Jōyō kanji, or 0 if
+// the rune is not a Jōyō kanji.
+func KanjiGrade(r rune) int {
+	return kanjiGrades[r]
+}
+
+// IsKyoikuKanji returns true if a rune is one of the 1,026 Kyōiku kanji taught
+// in elementary school.
+func IsKyoikuKanji(r rune) bool {
+	g := kanjiGrades[r]
+	return g > 0 && g < KanjiGradeSecondary
+}
+
+// GradeHistogram counts the kanji of a string by the grade in which they are
+// taught, as returned by KanjiGrades. Index 0 counts kanji which are not
+// Jōyō kanji, indexes 1 to 6 count Kyōiku kanji by grade, and index
+// KanjiGradeSecondary counts the remaining Jōyō kanji.
+type GradeHistogram [KanjiGradeSecondary + 1]int
+
+// Max returns the highest grade counted in the histogram, or 0 if no Jōyō
+// kanji were counted.
+func (h GradeHistogram) Max() int {
+	for g := KanjiGradeSecondary; g > 0; g-- {
+		if h[g] > 0 {
+			return g
+		}
+	}
+	return 0
+}
+
+// KanjiGrades returns a histogram of the grades of all kanji found in a
+// string, as found by ExtractKanji. Each instance of a repeated kanji is
+// counted, but kanji marks (々, 〆, 〇, 〻), which have no grade, are not.
+func KanjiGrades(s string) GradeHistogram {
+	var h GradeHistogram
+	for _, k := range ExtractKanji(s) {
+		r := []rune(k)[0]
+		if strings.ContainsRune(kanjiMarks, r) {
+			continue
+		}
+		h[KanjiGrade(r)]++
+	}
+	return h
+}
+
+// MaxKanjiGrade returns the highest grade of the Jōyō kanji found in a string,
+// or 0 if the string contains no Jōyō kanji. Kanji which are not Jōyō kanji
+// are not considered, and can be counted using KanjiGrades.
+func MaxKanjiGrade(s string) int {
+	return KanjiGrades(s).Max()
+}
@@ -0,0 +1,63 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKanjiGradeCounts(t *testing.T) {
+	counts := GradeHistogram{}
+	for r, g := range kanjiGrades {
+		if _, ok := joyoKanjiAliases[r]; !ok {
+			counts[g]++
+		}
+	}
+
+	require.Equal(t, GradeHistogram{0, 80, 160, 200, 202, 193, 191, 1110}, counts)
+}
+
+func TestKanjiGrade(t *testing.T) {
+	tt := []struct {
+		r rune
+		g int
+	}{
+		{'一', 1},
+		{'山', 1},
+		{'語', 2},
+		{'漢', 3},
+		{'媛', 4},
+		{'移', 5},
+		{'骨', 6},
+		{'鬱', KanjiGradeSecondary},
+		{'叱', KanjiGradeSecondary},
+		{'翔', 0},
+		{'あ', 0},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.g, KanjiGrade(v.r), "testing (%d) %c = %v", i, v.r, v.g)
+		require.Equal(t, v.g > 0 && v.g <= 6, IsKyoikuKanji(v.r), "testing (%d) %c", i, v.r)
+	}
+}
+
+func TestKanjiGrades(t *testing.T) {
+	tt := []struct {
+		s   string
+		r   GradeHistogram
+		max int
+	}{
+		{"山と川", GradeHistogram{0, 2}, 1},
+		{"日本語を勉強する", GradeHistogram{0, 2, 2, 1}, 3},
+		{"翔は憂鬱", GradeHistogram{1, 0, 0, 0, 0, 0, 0, 2}, KanjiGradeSecondary},
+		{"翔", GradeHistogram{1}, 0},
+		{"人々", GradeHistogram{0, 1}, 1},
+		{"〆切", GradeHistogram{0, 0, 1}, 2},
+		{"ひらがな", GradeHistogram{}, 0},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, KanjiGrades(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+		require.Equal(t, v.max, MaxKanjiGrade(v.s), "testing (%d) %s = %v", i, v.s, v.max)
+	}
+}
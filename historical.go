@@ -0,0 +1,176 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+// HistoricalToModern converts text written in historical kana orthography
+// (rekishiteki kanazukai) into modern kana orthography, such that けふ becomes
+// きょう, ゐる becomes いる, and くわし becomes かし. The conversion is rule
+// based, so words which break the historical rules, such as compounds, may
+// not convert correctly. は and へ are assumed to be particles, and are
+// preserved, if they end a run of kana.
+func HistoricalToModern(s string) string {
+	return historicalToModern(s, false)
+}
+
+// HistoricalToRomaji converts text written in historical kana orthography
+// into romaji reflecting its modern pronunciation, using macrons to indicate
+// the long vowels read from historical spellings, such that けふ becomes kyō.
+// Other vowels are romanized as ToRomaji, such that おもふ becomes omou, and
+// kanji are left unchanged.
+func HistoricalToRomaji(s string) string {
+	return historicalLongVowels.Replace(ToRomaji(historicalToModern(s, true), true))
+}
+
+// historicalLongVowel marks the long vowels read from historical spellings
+// by historicalToModern, so that only those are written with macrons.
+const historicalLongVowel = "\uE000"
+
+// historicalLongVowels replaces the marked long vowels in romaji with their
+// macron equivalents.
+var historicalLongVowels = strings.NewReplacer(
+	"o"+historicalLongVowel, "ō",
+	"u"+historicalLongVowel, "ū",
+	historicalLongVowel, "",
+)
+
+// historicalToModern converts text written in historical kana orthography
+// into modern kana orthography. If marked is true, long vowels read from
+// historical spellings are written with historicalLongVowel in place of う.
+func historicalToModern(s string, marked bool) string {
+	in := []rune(expandKanaIterationMarks(s))
+	out := make([]rune, 0, len(in))
+	for i := 0; i < len(in); {
+		if !isHistoricalKana(in[i]) {
+			out = append(out, in[i])
+			i++
+			continue
+		}
+
+		j, katakana := i, unicode.In(in[i], unicode.Katakana)
+		for j < len(in) && isHistoricalKana(in[j]) && unicode.In(in[j], unicode.Katakana) == katakana {
+			j++
+		}
+
+		// Kana following kanji is treated as okurigana, and so is considered
+		// to be within a word rather than at the start of one.
+		medial := i > 0 && IsKanjiRune(in[i-1], KanjiAllowMarks)
+		word := historicalRunToModern(strings.Map(KatakanaToHiragana, string(in[i:j])), medial, marked)
+		if katakana {
+			word = strings.Map(HiraganaToKatakana, word)
+		}
+
+		out = append(out, []rune(word)...)
+		i = j
+	}
+
+	return string(out)
+}
+
+// historicalRunToModern converts a run of historical hiragana into modern
+// hiragana. If medial is true, the run is considered to follow the start of
+// a word. If marked is true, long vowels are marked with historicalLongVowel.
+func historicalRunToModern(s string, medial, marked bool) string {
+	r := []rune(historicalKana.Replace(s))
+	long := "う"
+	if marked {
+		long = historicalLongVowel
+	}
+
+	// A ふ which ends the run, or is followed by a noun or particle such as
+	// こと, usually ends a verb, as in いふ (言ふ) or かふ (買ふ), and is read as
+	// う without contracting the preceding あ-row kana or い.
+	verbFu := make([]bool, len(r))
+	for i := 1; i < len(r); i++ {
+		verbFu[i] = r[i] == 'ふ' && isHistoricalVerbEnd(r[i+1:])
+	}
+
+	// は-row kana are read as わ-row kana within a word.
+	for i := range r {
+		if i == 0 && !medial {
+			continue
+		}
+
+		if (r[i] == 'は' || r[i] == 'へ') && i == len(r)-1 {
+			continue
+		}
+
+		if v, ok := historicalHaRow[r[i]]; ok {
+			r[i] = v
+		}
+	}
+
+	// Vowels followed by う are read as long vowels.
+	var b strings.Builder
+	for i := 0; i < len(r); i++ {
+		if i+2 < len(r) && r[i+1] == 'や' && r[i+2] == 'う' {
+			if v, ok := historicalIRow[r[i]]; ok && r[i] != 'い' {
+				b.WriteString(strings.Replace(v, "ゅ", "ょ", 1) + long)
+				i += 2
+				continue
+			}
+		}
+
+		if i+1 < len(r) && r[i+1] == 'う' {
+			verb := verbFu[i+1]
+			if v, ok := historicalARow[r[i]]; ok && !verb {
+				b.WriteString(v + long)
+				i++
+				continue
+			}
+
+			if v, ok := historicalIRow[r[i]]; ok && !(verb && r[i] == 'い') {
+				b.WriteString(v + long)
+				i++
+				continue
+			}
+
+			if v, ok := historicalERow[r[i]]; ok {
+				b.WriteString(v + long)
+				i++
+				continue
+			}
+		}
+
+		b.WriteRune(r[i])
+	}
+
+	// ぢ and づ are written じ and ず, except where they follow ち and つ.
+	r = []rune(b.String())
+	for i := range r {
+		if r[i] == 'ぢ' && (i == 0 || r[i-1] != 'ち') {
+			r[i] = 'じ'
+		}
+
+		if r[i] == 'づ' && (i == 0 || r[i-1] != 'つ') {
+			r[i] = 'ず'
+		}
+	}
+
+	return string(r)
+}
+
+// isHistoricalVerbEnd returns true if the kana following a ふ are empty or
+// begin with a noun or particle which commonly follows a verb.
+func isHistoricalVerbEnd(r []rune) bool {
+	if len(r) == 0 {
+		return true
+	}
+
+	s := string(r)
+	for _, f := range historicalVerbFollowers {
+		if strings.HasPrefix(s, f) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isHistoricalKana returns true if a rune is a hiragana or katakana character
+// which may be converted by HistoricalToModern.
+func isHistoricalKana(r rune) bool {
+	return r != 'ー' && unicode.In(r, unicode.Hiragana, unicode.Katakana)
+}
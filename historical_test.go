@@ -0,0 +1,80 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalToModern(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"けふ", "きょう"},      // e-row + fu
+		{"てふてふ", "ちょうちょう"}, // repeated e-row + fu
+		{"ゐる", "いる"},       // obsolete wi
+		{"ゑむ", "えむ"},       // obsolete we
+		{"くわし", "かし"},      // kwa
+		{"ぐわん", "がん"},      // gwa
+		{"かはる", "かわる"},     // medial ha
+		{"おもひ", "おもい"},     // final hi
+		{"おほし", "おおし"},     // medial ho
+		{"言ふ", "言う"},       // okurigana fu
+		{"いふ", "いう"},       // final fu after i
+		{"あふ", "あう"},       // final fu after a
+		{"かふ", "かう"},       // final fu after a-row
+		{"おもふ", "おもう"},     // final fu after o-row
+		{"いふこと", "いうこと"},   // verb fu before a noun
+		{"かふもの", "かうもの"},   // verb fu before a noun
+		{"いふを", "いうを"},     // verb fu before a particle
+		{"たふとし", "とうとし"},   // medial fu after a-row
+		{"かふし", "こうし"},     // medial fu after a-row
+		{"じふ", "じゅう"},      // final fu after i-row
+		{"思はず", "思わず"},     // okurigana ha
+		{"月は", "月は"},       // particle ha
+		{"京へ", "京へ"},       // particle he
+		{"これは", "これは"},     // particle ha after kana
+		{"はな", "はな"},       // initial ha
+		{"かう", "こう"},       // a-row + u
+		{"やう", "よう"},       // ya + u
+		{"きやう", "きょう"},     // i-row + yau
+		{"しう", "しゅう"},      // i-row + u
+		{"いづこ", "いずこ"},     // dzu
+		{"ちぢむ", "ちぢむ"},     // preserved ji
+		{"つづく", "つづく"},     // preserved zu
+		{"こゝろ", "こころ"},     // iteration mark
+		{"佐々木", "佐々木"},     // kanji iteration mark
+		{"ヰル", "イル"},       // katakana
+		{"カウ", "コウ"},       // katakana
+		{"abc", "abc"},     // latin
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, HistoricalToModern(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestHistoricalToRomaji(t *testing.T) {
+	tt := [][]string{
+		{"けふ", "kyō"},
+		{"ゐる", "iru"},
+		{"くわし", "kashi"},
+		{"てふてふ", "chōchō"},
+		{"いづこ", "izuko"},
+		{"かう", "kō"},
+		{"おもふ", "omou"},
+		{"いふ", "iu"},
+		{"おほし", "ooshi"},
+		{"カウ", "kō"},
+		{"言ふ", "言u"},
+		{"けふの月", "kyōno月"},
+		{"東京", "東京"},
+		{"時々けふ", "時々kyō"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v[1], HistoricalToRomaji(v[0]), "testing (%d) %s = %s", i, v[0], v[1])
+	}
+}
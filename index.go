@@ -0,0 +1,129 @@
+package kana
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Index is an in-memory prefix search index of words by their readings, for
+// autocompletion. Queries may be written in kana or romaji, and incomplete
+// romaji at the end of a query is expanded into every kana it could become,
+// such that shin matches both しん and しな, and sh matches し, しゃ, しゅ
+// and しょ. The zero value is ready to use. Search sorts the words inserted
+// since the previous search, so an Index must not be searched concurrently.
+type Index struct {
+	entries []IndexEntry        // sorted by reading if sorted is true.
+	held    map[IndexEntry]bool // the entries held, for finding duplicates.
+	sorted  bool
+}
+
+// IndexEntry is a word and its reading held by an Index.
+type IndexEntry struct {
+	// Surface is the word as it is written, such as 新聞.
+	Surface string
+
+	// Reading is the reading of the word in hiragana, such as しんぶん.
+	Reading string
+}
+
+// Insert adds a word to the index. The reading may be written in hiragana,
+// katakana or romaji, and is stored in hiragana. If the reading is empty, the
+// word is taken to be its own reading. Inserting a word and reading which are
+// already held by the index has no effect.
+func (ix *Index) Insert(surface, reading string) {
+	if reading == "" {
+		reading = surface
+	}
+	e := IndexEntry{Surface: surface, Reading: ToHiragana(normalizeWidth(reading))}
+
+	if ix.held[e] {
+		return
+	}
+	if ix.held == nil {
+		ix.held = map[IndexEntry]bool{}
+	}
+
+	ix.held[e] = true
+	ix.entries = append(ix.entries, e)
+	ix.sorted = false
+}
+
+// Len returns the number of words held by the index.
+func (ix *Index) Len() int {
+	return len(ix.entries)
+}
+
+// sort sorts the entries of the index by reading, if any have been inserted
+// since the index was last sorted, so that many words may be inserted without
+// sorting the index after each.
+func (ix *Index) sort() {
+	if ix.sorted {
+		return
+	}
+
+	sort.Slice(ix.entries, func(i, j int) bool {
+		a, b := ix.entries[i], ix.entries[j]
+		if a.Reading != b.Reading {
+			return a.Reading < b.Reading
+		}
+		return a.Surface < b.Surface
+	})
+	ix.sorted = true
+}
+
+// Search returns the words whose readings begin with the query, ranked so
+// that words read exactly as the query come first, followed by words whose
+// readings begin with every mora of the query, then by words matching only an
+// expansion of incomplete romaji at the end of the query, such that for shin,
+// しん precedes しんぶん, which precedes しな. Ties are ranked by the length
+// of the reading, then in dictionary (gojūon) order of the reading.
+func (ix *Index) Search(query string) []IndexEntry {
+	ix.sort()
+
+	seen := map[int]bool{}
+	for _, p := range RomajiPrefixes(query) {
+		i := sort.Search(len(ix.entries), func(i int) bool {
+			return ix.entries[i].Reading >= p
+		})
+		for ; i < len(ix.entries) && strings.HasPrefix(ix.entries[i].Reading, p); i++ {
+			seen[i] = true
+		}
+	}
+
+	whole := ToHiragana(strings.ToLower(normalizeWidth(query)))
+	complete := isRomajiComplete(whole)
+	rank := func(reading string) int {
+		switch {
+		case complete && reading == whole:
+			return 0
+		case complete && strings.HasPrefix(reading, whole):
+			return 1
+		}
+		return 2
+	}
+
+	matches := make([]IndexEntry, 0, len(seen))
+	for i := range ix.entries {
+		if seen[i] {
+			matches = append(matches, ix.entries[i])
+		}
+	}
+
+	var c Collator
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if ra, rb := rank(a.Reading), rank(b.Reading); ra != rb {
+			return ra < rb
+		}
+		if la, lb := utf8.RuneCountInString(a.Reading), utf8.RuneCountInString(b.Reading); la != lb {
+			return la < lb
+		}
+		if n := c.Compare(a.Reading, b.Reading); n != 0 {
+			return n < 0
+		}
+		return a.Surface < b.Surface
+	})
+
+	return matches
+}
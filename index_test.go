@@ -0,0 +1,109 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexSearch(t *testing.T) {
+	var ix Index
+	ix.Insert("新聞", "しんぶん")
+	ix.Insert("新", "しん")
+	ix.Insert("信号", "シンゴウ")
+	ix.Insert("品", "shina")
+	ix.Insert("死", "し")
+	ix.Insert("写真", "しゃしん")
+	ix.Insert("主人", "しゅじん")
+	ix.Insert("書店", "しょてん")
+	ix.Insert("寿司", "すし")
+	ix.Insert("切手", "きって")
+	ix.Insert("東京", "とうきょう")
+	ix.Insert("ひらがな", "")
+	ix.Insert("新聞", "しんぶん")
+	require.Equal(t, 12, ix.Len())
+
+	tt := []struct {
+		s string
+		r []string
+	}{
+		{"shin", []string{"新", "信号", "新聞", "品"}},
+		{"sh", []string{"死", "品", "新", "写真", "主人", "書店", "信号", "新聞"}},
+		{"sho", []string{"書店"}},
+		{"しんぶ", []string{"新聞"}},
+		{"SHINB", []string{"新聞"}},
+		{"kit", []string{"切手"}},
+		{"kitte", []string{"切手"}},
+		{"toukyo", []string{"東京"}},
+		{"hira", []string{"ひらがな"}},
+		{"su", []string{"寿司"}},
+		{"ky", []string{}},
+		{"x", []string{}},
+	}
+
+	for i, v := range tt {
+		surfaces := []string{}
+		for _, e := range ix.Search(v.s) {
+			surfaces = append(surfaces, e.Surface)
+		}
+		require.Equal(t, v.r, surfaces, "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestIndexSearchReading(t *testing.T) {
+	var ix Index
+	ix.Insert("信号", "シンゴウ")
+	require.Equal(t, []IndexEntry{{Surface: "信号", Reading: "しんごう"}}, ix.Search("shingo"))
+}
+
+func TestIndexSearchRank(t *testing.T) {
+	var ix Index
+	ix.Insert("新幹線", "しんかんせん")
+	ix.Insert("死ぬ", "しぬ")
+	ix.Insert("品", "しな")
+	ix.Insert("新聞", "しんぶん")
+	ix.Insert("芯", "しん")
+
+	surfaces := []string{}
+	for _, e := range ix.Search("shin") {
+		surfaces = append(surfaces, e.Surface)
+	}
+	require.Equal(t, []string{"芯", "新聞", "新幹線", "品", "死ぬ"}, surfaces)
+}
+
+func TestIndexInsertAfterSearch(t *testing.T) {
+	var ix Index
+	ix.Insert("新聞", "しんぶん")
+	require.Len(t, ix.Search("shin"), 1)
+
+	ix.Insert("芯", "しん")
+	ix.Insert("新聞", "しんぶん")
+	require.Equal(t, 2, ix.Len())
+	require.Equal(t, []IndexEntry{
+		{Surface: "芯", Reading: "しん"},
+		{Surface: "新聞", Reading: "しんぶん"},
+	}, ix.Search("shin"))
+}
+
+func BenchmarkIndexInsert(b *testing.B) {
+	kana := []rune("あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわをん")
+	readings := make([]string, 0, 50000)
+	for _, x := range kana {
+		for _, y := range kana {
+			for _, z := range kana {
+				if len(readings) < cap(readings) {
+					readings = append(readings, string([]rune{x, y, z}))
+				}
+			}
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var ix Index
+		for _, r := range readings {
+			ix.Insert(r, r)
+		}
+		ix.Search("ka")
+	}
+}
@@ -0,0 +1,73 @@
+package kana
+
+import (
+	"math"
+	"unicode"
+)
+
+// irohaKana contains the kana of the iroha poem, indexed by position.
+var irohaKana = []rune(irohaOrder)
+
+// IrohaIndex returns the position of a kana in the iroha poem, from 1 for い
+// to 47 for す, or 0 if the rune is not one of the kana of the poem. Katakana,
+// voiced and small kana share the position of their unvoiced full size
+// hiragana, such that ロ, ば and ゃ are positioned as ろ, は and や. ん, which
+// does not appear in the poem, has no position.
+func IrohaIndex(r rune) int {
+	if u, ok := unvoicedKana[r]; ok {
+		r = u
+	}
+	if l, ok := smallKana[r]; ok {
+		r = l
+	}
+
+	i := irohaIndex[KatakanaToHiragana(r)]
+	if int(i) > len(irohaKana) {
+		return 0
+	}
+	return int(i)
+}
+
+// IrohaKana returns the hiragana at a position in the iroha poem, from 1 for
+// い to 47 for す.
+func IrohaKana(i int) (rune, bool) {
+	if i < 1 || i > len(irohaKana) {
+		return 0, false
+	}
+	return irohaKana[i-1], true
+}
+
+// FormatIroha formats a number as an iroha list marker in hiragana, such as
+// い for 1, ろ for 2 and は for 3. Numbers above 47 continue with pairs of
+// kana, such that 48 is いい and 49 is いろ. Numbers below 1 return an empty
+// string.
+func FormatIroha(n int) string {
+	out := []rune{}
+	for ; n > 0; n = (n - 1) / len(irohaKana) {
+		out = append([]rune{irohaKana[(n-1)%len(irohaKana)]}, out...)
+	}
+	return string(out)
+}
+
+// ParseIroha is the inverse of FormatIroha, returning the number represented
+// by an iroha list marker in hiragana or katakana. False is returned if the
+// marker is invalid, or represents a number too large for an int.
+func ParseIroha(s string) (int, bool) {
+	n := 0
+	for _, r := range s {
+		if !unicode.In(r, unicode.Hiragana, unicode.Katakana) {
+			return 0, false
+		}
+
+		i := irohaIndex[KatakanaToHiragana(r)]
+		if i == 0 || int(i) > len(irohaKana) {
+			return 0, false
+		}
+		if n > (math.MaxInt-int(i))/len(irohaKana) {
+			return 0, false // the number overflows an int.
+		}
+		n = n*len(irohaKana) + int(i)
+	}
+
+	return n, n > 0
+}
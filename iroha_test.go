@@ -0,0 +1,110 @@
+package kana
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIrohaIndex(t *testing.T) {
+	tt := []struct {
+		r rune
+		i int
+	}{
+		{'い', 1},
+		{'ろ', 2},
+		{'は', 3},
+		{'ば', 3},
+		{'パ', 3},
+		{'ゃ', 29},
+		{'ゐ', 25},
+		{'す', 47},
+		{'ん', 0},
+		{'a', 0},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.i, IrohaIndex(v.r), "testing (%d) %c = %v", i, v.r, v.i)
+	}
+}
+
+func TestIrohaKana(t *testing.T) {
+	tt := []struct {
+		i  int
+		r  rune
+		ok bool
+	}{
+		{1, 'い', true},
+		{3, 'は', true},
+		{47, 'す', true},
+		{0, 0, false},
+		{48, 0, false},
+	}
+
+	for i, v := range tt {
+		r, ok := IrohaKana(v.i)
+		require.Equal(t, v.ok, ok, "testing (%d) %d", i, v.i)
+		require.Equal(t, v.r, r, "testing (%d) %d = %c", i, v.i, v.r)
+	}
+}
+
+func TestFormatIroha(t *testing.T) {
+	tt := []struct {
+		n int
+		r string
+	}{
+		{1, "い"},
+		{2, "ろ"},
+		{3, "は"},
+		{47, "す"},
+		{48, "いい"},
+		{49, "いろ"},
+		{94, "いす"},
+		{95, "ろい"},
+		{0, ""},
+		{-1, ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, FormatIroha(v.n), "testing (%d) %d = %v", i, v.n, v.r)
+		if v.n > 0 {
+			n, ok := ParseIroha(v.r)
+			require.True(t, ok)
+			require.Equal(t, v.n, n, "testing (%d) %s = %v", i, v.r, v.n)
+		}
+	}
+}
+
+func TestParseIroha(t *testing.T) {
+	tt := []struct {
+		s  string
+		n  int
+		ok bool
+	}{
+		{"ハ", 3, true},
+		{"いろ", 49, true},
+		{"ん", 0, false},
+		{"ば", 0, false},
+		{"a", 0, false},
+		{"", 0, false},
+		{strings.Repeat("い", 31), 0, false},
+		{FormatIroha(math.MaxInt), math.MaxInt, true},
+	}
+
+	for i, v := range tt {
+		n, ok := ParseIroha(v.s)
+		require.Equal(t, v.ok, ok, "testing (%d) %s", i, v.s)
+		require.Equal(t, v.n, n, "testing (%d) %s = %v", i, v.s, v.n)
+	}
+}
+
+func TestCollatorIroha(t *testing.T) {
+	s := []string{"あさ", "いぬ", "ろば", "はな", "ん", "すし", "ばら", "イカ"}
+	Collator{Order: OrderIroha}.Sort(s)
+	require.Equal(t, []string{"いぬ", "イカ", "ろば", "はな", "ばら", "あさ", "すし", "ん"}, s)
+
+	require.Equal(t, -1, Collator{Order: OrderIroha}.Compare("ろ", "あ"))
+	require.Equal(t, 1, Collator{}.Compare("ろ", "あ"))
+}
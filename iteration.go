@@ -0,0 +1,187 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+// iterationMarks contains all kana and kanji iteration marks which are
+// resolved by ExpandIterationMarks.
+const iterationMarks = "ゝゞヽヾ々〻"
+
+// kanaIterationMarks contains the kana iteration marks.
+const kanaIterationMarks = "ゝゞヽヾ"
+
+// ExpandIterationMarks replaces kana iteration marks (ゝ, ゞ, ヽ, ヾ) and
+// kanji iteration marks (々, 〻) with the characters they represent. ゝ and ヽ
+// repeat the preceding kana unvoiced, ゞ and ヾ repeat it voiced, and 々 and
+// 〻 repeat the preceding kanji. A run of kana marks or of kanji marks
+// directly following the same number of characters repeats the whole run, so
+// 部分々々 becomes 部分部分. Marks which cannot be resolved are left in place.
+func ExpandIterationMarks(s string) string {
+	return expandIterationMarks(s, iterationMarks)
+}
+
+// expandKanaIterationMarks replaces only the kana iteration marks (ゝ, ゞ, ヽ,
+// ヾ) with the kana they represent, as ExpandIterationMarks, leaving kanji
+// iteration marks in place.
+func expandKanaIterationMarks(s string) string {
+	return expandIterationMarks(s, kanaIterationMarks)
+}
+
+// expandIterationMarks replaces the iteration marks found in marks with the
+// characters they represent.
+func expandIterationMarks(s string, marks string) string {
+	if !strings.ContainsAny(s, marks) {
+		return s
+	}
+
+	in := []rune(s)
+	out := make([]rune, 0, len(in))
+	for i := 0; i < len(in); {
+		if !strings.ContainsRune(marks, in[i]) {
+			out = append(out, in[i])
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(in) && strings.ContainsRune(marks, in[j]) {
+			j++
+		}
+
+		// A run of marks of one kind repeats the run of characters before it,
+		// otherwise each mark repeats the character immediately preceding it.
+		start := len(out) - (j - i)
+		if j-i == 1 || start < 0 || !isIterationMarkRun(in[i:j]) {
+			start = len(out) - 1
+			for k := i; k < j; k++ {
+				if start < 0 {
+					out = append(out, in[k])
+				} else {
+					out = append(out, iterate(out[len(out)-1], in[k]))
+				}
+			}
+		} else {
+			for k := i; k < j; k++ {
+				out = append(out, iterate(out[start+k-i], in[k]))
+			}
+		}
+
+		i = j
+	}
+
+	return string(out)
+}
+
+// isIterationMarkRun returns true if a run of iteration marks are either all
+// kana iteration marks or all kanji iteration marks, such that the run may
+// repeat a run of characters of the same kind.
+func isIterationMarkRun(marks []rune) bool {
+	kana := strings.ContainsRune(kanaIterationMarks, marks[0])
+	for _, m := range marks[1:] {
+		if strings.ContainsRune(kanaIterationMarks, m) != kana {
+			return false
+		}
+	}
+	return true
+}
+
+// iterate returns the character represented by an iteration mark when it
+// follows the character prev. If the mark cannot be applied to prev, the
+// mark is returned unchanged.
+func iterate(prev, mark rune) rune {
+	switch mark {
+	case 'ゝ', 'ヽ':
+		if isIterableKana(prev) {
+			return unvoiceKana(prev)
+		}
+	case 'ゞ', 'ヾ':
+		if isIterableKana(prev) {
+			return voiceKana(unvoiceKana(prev))
+		}
+	case '々', '〻':
+		if isIterableKanji(prev) {
+			return prev
+		}
+	}
+
+	return mark
+}
+
+// ContractIterationMarks is the inverse of ExpandIterationMarks, replacing
+// kana which repeat the preceding kana with ゝ or ヽ, or ゞ or ヾ if the
+// repetition is voiced, and kanji which repeat the preceding kanji with 々.
+// A character following a contracted repeat is never contracted itself, so
+// ここここ becomes こゝこゝ.
+func ContractIterationMarks(s string) string {
+	in := []rune(s)
+	out := make([]rune, len(in))
+	copy(out, in)
+	for i := 1; i < len(in); i++ {
+		prev, r := in[i-1], in[i]
+		if out[i-1] != prev {
+			continue
+		}
+
+		switch {
+		case isIterableKanji(r) && r == prev:
+			out[i] = '々'
+		case isIterableKana(r) && isIterableKana(prev) &&
+			unicode.In(r, unicode.Hiragana) == unicode.In(prev, unicode.Hiragana) &&
+			unvoiceKana(r) == unvoiceKana(prev):
+			mark := 'ゝ'
+			if r != unvoiceKana(r) {
+				if r != voiceKana(unvoiceKana(r)) {
+					continue // semi-voiced kana have no iteration mark.
+				}
+				mark = 'ゞ'
+			}
+			if !unicode.In(r, unicode.Hiragana) {
+				mark = HiraganaToKatakana(mark)
+			}
+			out[i] = mark
+		}
+	}
+
+	return string(out)
+}
+
+// voiceKana returns the voiced form of a kana, or the kana unchanged if it
+// has no voiced form.
+func voiceKana(r rune) rune {
+	if v, ok := voicedKana[r]; ok {
+		return v
+	}
+	return r
+}
+
+// unvoiceKana returns the unvoiced form of a voiced or semi-voiced kana, or
+// the kana unchanged if it is already unvoiced.
+func unvoiceKana(r rune) rune {
+	if v, ok := unvoicedKana[r]; ok {
+		return v
+	}
+	return r
+}
+
+// isIterableKana returns true if a kana may be repeated by a kana iteration
+// mark. Small kana, moraic n's and the iteration marks themselves cannot.
+func isIterableKana(r rune) bool {
+	if _, ok := smallKana[r]; ok {
+		return false
+	}
+
+	switch r {
+	case 'ん', 'ン', 'ゝ', 'ゞ', 'ヽ', 'ヾ', 'ゟ', 'ヿ', '゛', '゜', '゙', '゚', '・':
+		return false
+	}
+
+	return unicode.In(r, unicode.Hiragana, unicode.Katakana)
+}
+
+// isIterableKanji returns true if a kanji may be repeated by a kanji
+// iteration mark.
+func isIterableKanji(r rune) bool {
+	return IsKanjiRune(r, KanjiStrict)
+}
@@ -0,0 +1,94 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandIterationMarks(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"こゝろ", "こころ"},               // hiragana repeat
+		{"いすゞ", "いすず"},               // hiragana voiced repeat
+		{"ぶゝ", "ぶふ"},                 // unvoiced repeat of a voiced kana
+		{"ぶゞ", "ぶぶ"},                 // voiced repeat of a voiced kana
+		{"ぱゞ", "ぱば"},                 // voiced repeat of a semi-voiced kana
+		{"あゞ", "ああ"},                 // unvoiceable kana
+		{"バナヽ", "バナナ"},               // katakana repeat
+		{"イスヾ", "イスズ"},               // katakana voiced repeat
+		{"ヰヾ", "ヰヸ"},                 // archaic katakana
+		{"人々", "人人"},                 // kanji repeat
+		{"時〻", "時時"},                 // vertical kanji repeat
+		{"部分々々", "部分部分"},             // kanji run repeat
+		{"ところゝゝ", "ところころ"},           // kana run repeat
+		{"いすゞ々", "いすず々"},             // mixed kana and kanji marks
+		{"民主々義", "民主主義"},             // kanji repeat within word
+		{"ゝ", "ゝ"},                   // nothing to repeat
+		{"々", "々"},                   // nothing to repeat
+		{"ひと々", "ひと々"},               // kanji mark after kana
+		{"人ゝ", "人ゝ"},                 // kana mark after kanji
+		{"んゝ", "んゝ"},                 // moraic n cannot be repeated
+		{"ひらがな", "ひらがな"},             // no marks
+		{"", ""},                     // empty string
+		{"こゝろ and 人々", "こころ and 人人"}, // mixed
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ExpandIterationMarks(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestContractIterationMarks(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"こころ", "こゝろ"},
+		{"いすず", "いすゞ"},
+		{"ぶぶ", "ぶゞ"},
+		{"ぶふ", "ぶゝ"},
+		{"ぱぱ", "ぱぱ"}, // semi-voiced kana cannot be contracted
+		{"バナナ", "バナヽ"},
+		{"イスズ", "イスヾ"},
+		{"人人", "人々"},
+		{"二〇〇", "二〇〇"},   // numeral zero is not contracted
+		{"ここここ", "こゝこゝ"}, // each repeat is contracted against the original
+		{"かカ", "かカ"},     // kana of different scripts
+		{"っっ", "っっ"},     // small kana cannot be contracted
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ContractIterationMarks(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+		require.Equal(t, v.s, ExpandIterationMarks(ContractIterationMarks(v.s)), "testing (%d) %s round trip", i, v.s)
+	}
+}
+
+func TestToRomajiShouldExpandIterationMarks(t *testing.T) {
+	tt := [][]string{
+		{"いすゞ", "isuzu"},
+		{"こゝろ", "kokoro"},
+		{"バナヽ", "BANANA"},
+		{"みすゞ", "misuzu"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v[1], ToRomajiCased(v[0], false), "testing (%d) %s = %s", i, v[0], v[1])
+	}
+}
+
+func TestToRomajiShouldPreserveKanjiIterationMarks(t *testing.T) {
+	tt := [][]string{
+		{"佐々木", "佐々木"},
+		{"時々", "時々"},
+		{"時々こゝろ", "時々kokoro"},
+		{"部分々々", "部分々々"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v[1], ToRomaji(v[0], false), "testing (%d) %s = %s", i, v[0], v[1])
+	}
+}
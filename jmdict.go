@@ -0,0 +1,374 @@
+package kana
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// DictionaryEntry is an entry of a JMdict or EDICT dictionary file, holding
+// the kanji forms of a word and their readings.
+type DictionaryEntry struct {
+	// Kanji contains the forms of the word written with kanji, such as 東京.
+	Kanji []DictionaryForm
+
+	// Readings contains the readings of the word in kana, such as とうきょう.
+	Readings []DictionaryForm
+}
+
+// DictionaryForm is a kanji form or reading of a DictionaryEntry.
+type DictionaryForm struct {
+	// Text is the form of the word, such as 東京 or とうきょう.
+	Text string
+
+	// Priority contains the JMdict priority tags of the form, such as news1,
+	// ichi1 or nf01. Common words in EDICT files are tagged P.
+	Priority []string
+
+	// Restrict contains the kanji forms to which a reading is restricted,
+	// from re_restr. A reading without restrictions applies to every kanji
+	// form.
+	Restrict []string
+
+	// NoKanji is true if a reading is not a true reading of the kanji forms,
+	// from re_nokanji.
+	NoKanji bool
+}
+
+// jmdictEntry is the XML structure of a JMdict entry. Senses are ignored.
+type jmdictEntry struct {
+	Kanji []struct {
+		Text     string   `xml:"keb"`
+		Priority []string `xml:"ke_pri"`
+	} `xml:"k_ele"`
+	Readings []struct {
+		Text     string    `xml:"reb"`
+		NoKanji  *struct{} `xml:"re_nokanji"`
+		Restrict []string  `xml:"re_restr"`
+		Priority []string  `xml:"re_pri"`
+	} `xml:"r_ele"`
+}
+
+// jmdictEntity matches the entity declarations of the JMdict DOCTYPE, such
+// as <!ENTITY n "noun (common) (futsuumeishi)">.
+var jmdictEntity = regexp.MustCompile(`<!ENTITY\s+([^\s%]+)\s+"([^"]*)"\s*>`)
+
+// LoadJMdict returns a MemoryDictionary of the entries read from a JMdict XML
+// file.
+func LoadJMdict(r io.Reader) (*MemoryDictionary, error) {
+	d := &MemoryDictionary{}
+	if err := d.LoadJMdict(r); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// LoadEDICT returns a MemoryDictionary of the entries read from an EDICT or
+// EDICT2 file.
+func LoadEDICT(r io.Reader) (*MemoryDictionary, error) {
+	d := &MemoryDictionary{}
+	if err := d.LoadEDICT(r); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// LoadJMdict adds the entries read from a JMdict XML file with AddEntry.
+func (d *MemoryDictionary) LoadJMdict(r io.Reader) error {
+	return ReadJMdict(r, func(e DictionaryEntry) error {
+		d.AddEntry(e)
+		return nil
+	})
+}
+
+// LoadEDICT adds the entries read from an EDICT or EDICT2 file with AddEntry.
+func (d *MemoryDictionary) LoadEDICT(r io.Reader) error {
+	return ReadEDICT(r, func(e DictionaryEntry) error {
+		d.AddEntry(e)
+		return nil
+	})
+}
+
+// AddEntry adds each kanji form of a dictionary entry with its preferred
+// reading. The preferred reading is the reading which applies to the kanji
+// form with the highest priority, or the first such reading if several have
+// the same priority. If a word is added by several entries, the reading with
+// the highest priority is kept, or the first reading added if several have
+// the same priority.
+func (d *MemoryDictionary) AddEntry(e DictionaryEntry) {
+	for _, k := range e.Kanji {
+		if k.Text == "" {
+			continue
+		}
+
+		best, reading := -1, ""
+		for _, r := range e.Readings {
+			if r.NoKanji || (len(r.Restrict) > 0 && !containsString(r.Restrict, k.Text)) {
+				continue
+			}
+			if p := formPriority(k, r); p > best {
+				best, reading = p, r.Text
+			}
+		}
+		if best < 0 {
+			continue
+		}
+
+		if _, ok := d.words[k.Text]; ok {
+			if p, ok := d.priority[k.Text]; !ok || best <= p {
+				continue // words added with Add, or with a higher priority.
+			}
+		}
+
+		d.Add(k.Text, reading)
+		if d.priority == nil {
+			d.priority = map[string]int{}
+		}
+		d.priority[k.Text] = best
+	}
+}
+
+// ReadJMdict reads the entries of a JMdict XML file, calling fn with each
+// entry in turn. The file is read as a stream, so that the full JMdict may be
+// read without holding it in memory. Reading stops at the first error
+// returned by fn.
+func ReadJMdict(r io.Reader, fn func(DictionaryEntry) error) error {
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidDictionary, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.Directive:
+			// The entities of the DOCTYPE, such as &n;, are used within entries.
+			for _, m := range jmdictEntity.FindAllStringSubmatch(string(t), -1) {
+				if dec.Entity == nil {
+					dec.Entity = map[string]string{}
+				}
+				dec.Entity[m[1]] = m[2]
+			}
+		case xml.StartElement:
+			if t.Name.Local != "entry" {
+				continue
+			}
+
+			var je jmdictEntry
+			if err := dec.DecodeElement(&je, &t); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidDictionary, err)
+			}
+
+			e := DictionaryEntry{}
+			for _, k := range je.Kanji {
+				e.Kanji = append(e.Kanji, DictionaryForm{Text: k.Text, Priority: k.Priority})
+			}
+			for _, r := range je.Readings {
+				e.Readings = append(e.Readings, DictionaryForm{
+					Text:     r.Text,
+					Priority: r.Priority,
+					Restrict: r.Restrict,
+					NoKanji:  r.NoKanji != nil,
+				})
+			}
+
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ReadEDICT reads the entries of a UTF-8 encoded EDICT or EDICT2 file,
+// calling fn with each entry in turn. Entries are written one per line, as in
+// 日本;日本国 [にほん(P);にっぽん] /(n) Japan/(P)/, where kanji forms and
+// readings are separated by semicolons, and are followed by tags such as (P),
+// or by the kanji forms to which a reading is restricted, such as
+// (日本国). Files encoded in EUC-JP must be converted to UTF-8 before
+// reading. Reading stops at the first error returned by fn.
+func ReadEDICT(r io.Reader, fn func(DictionaryEntry) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+
+		if !utf8.ValidString(s) {
+			return fmt.Errorf("%w: line %d is not utf-8", ErrInvalidDictionary, line)
+		}
+
+		e, ok := parseEDICTLine(s)
+		if !ok {
+			return fmt.Errorf("%w: line %d", ErrInvalidDictionary, line)
+		}
+
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidDictionary, err)
+	}
+
+	return nil
+}
+
+// parseEDICTLine parses a single entry of an EDICT or EDICT2 file.
+func parseEDICTLine(s string) (DictionaryEntry, bool) {
+	i := strings.Index(s, " /")
+	if i < 0 {
+		return DictionaryEntry{}, false
+	}
+	head, glosses := strings.TrimSpace(s[:i]), s[i+1:]
+
+	e := DictionaryEntry{}
+	kanji, readings := head, ""
+	if j := strings.Index(head, " ["); j >= 0 {
+		if !strings.HasSuffix(head, "]") {
+			return DictionaryEntry{}, false
+		}
+		kanji, readings = head[:j], head[j+2:len(head)-1]
+	} else {
+		kanji, readings = "", head // entries written in kana have no kanji forms.
+	}
+
+	for _, f := range strings.Split(kanji, ";") {
+		if text, tags := parseEDICTForm(f); text != "" {
+			e.Kanji = append(e.Kanji, DictionaryForm{Text: text, Priority: edictPriority(tags)})
+		}
+	}
+
+	for _, f := range strings.Split(readings, ";") {
+		text, tags := parseEDICTForm(f)
+		if text == "" {
+			continue
+		}
+
+		form := DictionaryForm{Text: text, Priority: edictPriority(tags)}
+		for _, t := range tags {
+			restrict := strings.Split(t, ",")
+			if isEDICTRestriction(restrict, e.Kanji) {
+				form.Restrict = append(form.Restrict, restrict...)
+			}
+		}
+		e.Readings = append(e.Readings, form)
+	}
+
+	if len(e.Readings) == 0 {
+		return DictionaryEntry{}, false
+	}
+
+	// Common words are tagged (P) in the glosses of EDICT files, and each
+	// common form is also tagged (P) in EDICT2 files.
+	if strings.Contains(glosses, "/(P)/") && !hasEDICTPriority(e) {
+		for i := range e.Kanji {
+			e.Kanji[i].Priority = []string{"P"}
+		}
+		for i := range e.Readings {
+			e.Readings[i].Priority = []string{"P"}
+		}
+	}
+
+	return e, true
+}
+
+// parseEDICTForm splits a kanji form or reading of an EDICT entry from the
+// parenthesised tags which follow it, such as にほん(P).
+func parseEDICTForm(s string) (string, []string) {
+	s = strings.TrimSpace(s)
+	i := strings.Index(s, "(")
+	if i < 0 {
+		return s, nil
+	}
+
+	text, tags := s[:i], []string{}
+	for _, t := range strings.Split(s[i:], ")") {
+		if t = strings.TrimPrefix(strings.TrimSpace(t), "("); t != "" {
+			tags = append(tags, t)
+		}
+	}
+
+	return text, tags
+}
+
+// edictPriority returns the priority tags of an EDICT form.
+func edictPriority(tags []string) []string {
+	for _, t := range tags {
+		if t == "P" {
+			return []string{"P"}
+		}
+	}
+	return nil
+}
+
+// isEDICTRestriction returns true if each element of a reading tag is a
+// kanji form of the entry, such that the tag restricts the reading to those
+// forms.
+func isEDICTRestriction(tag []string, kanji []DictionaryForm) bool {
+	for _, t := range tag {
+		found := false
+		for _, k := range kanji {
+			found = found || k.Text == t
+		}
+		if !found {
+			return false
+		}
+	}
+	return len(tag) > 0
+}
+
+// hasEDICTPriority returns true if any form of an entry has a priority tag.
+func hasEDICTPriority(e DictionaryEntry) bool {
+	for _, f := range append(append([]DictionaryForm{}, e.Kanji...), e.Readings...) {
+		if len(f.Priority) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// formPriority returns the priority of a reading of a kanji form, from the
+// priority tags held by both. Tags ending in 1, and the EDICT tag P, are
+// weighted above tags ending in 2, and the word frequency tags nf01 to nf48
+// are weighted by their rank.
+func formPriority(k, r DictionaryForm) int {
+	p := 0
+	for _, t := range r.Priority {
+		if !containsString(k.Priority, t) {
+			continue
+		}
+
+		switch {
+		case t == "P", strings.HasSuffix(t, "1") && !strings.HasPrefix(t, "nf"):
+			p += 100
+		case strings.HasPrefix(t, "nf"):
+			if n, err := strconv.Atoi(t[2:]); err == nil && n > 0 && n < 50 {
+				p += 50 - n
+			}
+		case strings.HasSuffix(t, "2"):
+			p += 50
+		}
+	}
+	return p
+}
+
+// containsString returns true if a slice of strings contains s.
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+package kana
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testJMdict = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE JMdict [
+<!ELEMENT JMdict (entry*)>
+<!ENTITY n "noun (common) (futsuumeishi)">
+<!ENTITY adj-na "adjectival nouns or quasi-adjectives (keiyodoshi)">
+]>
+<JMdict>
+<!-- JMdict created: 2023-10-16 -->
+<entry>
+<ent_seq>1582710</ent_seq>
+<k_ele><keb>日本</keb><ke_pri>ichi1</ke_pri><ke_pri>news1</ke_pri><ke_pri>nf01</ke_pri></k_ele>
+<k_ele><keb>日本国</keb></k_ele>
+<r_ele><reb>にっぽん</reb><re_pri>news1</re_pri><re_pri>nf01</re_pri></r_ele>
+<r_ele><reb>にほん</reb><re_pri>ichi1</re_pri><re_pri>news1</re_pri><re_pri>nf01</re_pri></r_ele>
+<r_ele><reb>にっぽんこく</reb><re_restr>日本国</re_restr></r_ele>
+<sense><pos>&n;</pos><gloss>Japan</gloss></sense>
+</entry>
+<entry>
+<ent_seq>1582711</ent_seq>
+<k_ele><keb>日本</keb></k_ele>
+<r_ele><reb>ひのもと</reb></r_ele>
+<sense><pos>&n;</pos><gloss>Japan (archaic)</gloss></sense>
+</entry>
+<entry>
+<ent_seq>1166210</ent_seq>
+<k_ele><keb>上手</keb><ke_pri>ichi1</ke_pri></k_ele>
+<r_ele><reb>じょうず</reb><re_pri>ichi1</re_pri></r_ele>
+<r_ele><reb>じょうて</reb></r_ele>
+<sense><pos>&adj-na;</pos><gloss>skillful</gloss></sense>
+</entry>
+<entry>
+<ent_seq>1000000</ent_seq>
+<k_ele><keb>煙草</keb></k_ele>
+<r_ele><reb>タバコ</reb><re_nokanji/></r_ele>
+<r_ele><reb>たばこ</reb></r_ele>
+<sense><pos>&n;</pos><gloss>tobacco</gloss></sense>
+</entry>
+<entry>
+<ent_seq>1000010</ent_seq>
+<r_ele><reb>ああ</reb></r_ele>
+<sense><gloss>ah!</gloss></sense>
+</entry>
+</JMdict>
+`
+
+const testEDICT = `　？？？ /EDICT, EDICT_SUB(P), EDICT2 Japanese-English Electronic Dictionary Files/Copyright Electronic Dictionary Research & Development Group - 2013/
+日本;日本国 [にほん(P);にっぽん;にっぽんこく(日本国)] /(n) Japan/(P)/EntL1582710X/
+日本 [ひのもと] /(n) (arch) Japan/EntL1582711/
+上手 [じょうず;じょうて] /(adj-na,n) skillful/(P)/
+ああ /(int) ah!/(P)/
+`
+
+func TestReadJMdict(t *testing.T) {
+	entries := []DictionaryEntry{}
+	err := ReadJMdict(strings.NewReader(testJMdict), func(e DictionaryEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	require.Equal(t, DictionaryEntry{
+		Kanji: []DictionaryForm{
+			{Text: "日本", Priority: []string{"ichi1", "news1", "nf01"}},
+			{Text: "日本国"},
+		},
+		Readings: []DictionaryForm{
+			{Text: "にっぽん", Priority: []string{"news1", "nf01"}},
+			{Text: "にほん", Priority: []string{"ichi1", "news1", "nf01"}},
+			{Text: "にっぽんこく", Restrict: []string{"日本国"}},
+		},
+	}, entries[0])
+	require.True(t, entries[3].Readings[0].NoKanji)
+
+	stop := errors.New("stop")
+	n := 0
+	err = ReadJMdict(strings.NewReader(testJMdict), func(e DictionaryEntry) error {
+		n++
+		return stop
+	})
+	require.Equal(t, stop, err)
+	require.Equal(t, 1, n)
+
+	err = ReadJMdict(strings.NewReader("<JMdict><entry><keb>日本</k_ele></JMdict>"), func(e DictionaryEntry) error {
+		return nil
+	})
+	require.True(t, errors.Is(err, ErrInvalidDictionary))
+}
+
+func TestReadEDICT(t *testing.T) {
+	entries := []DictionaryEntry{}
+	err := ReadEDICT(strings.NewReader(testEDICT), func(e DictionaryEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	require.Equal(t, DictionaryEntry{
+		Kanji: []DictionaryForm{
+			{Text: "日本"},
+			{Text: "日本国"},
+		},
+		Readings: []DictionaryForm{
+			{Text: "にほん", Priority: []string{"P"}},
+			{Text: "にっぽん"},
+			{Text: "にっぽんこく", Restrict: []string{"日本国"}},
+		},
+	}, entries[1])
+	require.Equal(t, DictionaryEntry{
+		Kanji:    []DictionaryForm{{Text: "上手", Priority: []string{"P"}}},
+		Readings: []DictionaryForm{{Text: "じょうず", Priority: []string{"P"}}, {Text: "じょうて", Priority: []string{"P"}}},
+	}, entries[3])
+
+	err = ReadEDICT(strings.NewReader("日本 [にほん\n"), func(e DictionaryEntry) error {
+		return nil
+	})
+	require.True(t, errors.Is(err, ErrInvalidDictionary))
+
+	err = ReadEDICT(strings.NewReader("\xc6\xfc\xcb\xdc [\xa4\xcb\xa4\xdb\xa4\xf3] /Japan/\n"), func(e DictionaryEntry) error {
+		return nil
+	})
+	require.True(t, errors.Is(err, ErrInvalidDictionary))
+}
+
+func TestLoadJMdict(t *testing.T) {
+	d, err := LoadJMdict(strings.NewReader(testJMdict))
+	require.NoError(t, err)
+	require.Equal(t, 4, d.Len())
+
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"日本", "にほん"},
+		{"日本国", "にっぽん"},
+		{"上手", "じょうず"},
+		{"煙草", "たばこ"},
+		{"ああ", ""},
+	}
+
+	for i, v := range tt {
+		r, _ := d.Lookup(v.s)
+		require.Equal(t, v.r, r, "testing (%d) %s = %v", i, v.s, v.r)
+	}
+
+	require.Equal(t, "nihonnojouzunatabako", ToRomajiWith("日本の上手な煙草", false, d))
+}
+
+func TestLoadEDICT(t *testing.T) {
+	d, err := LoadEDICT(strings.NewReader(testEDICT))
+	require.NoError(t, err)
+	require.Equal(t, 3, d.Len())
+
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"日本", "にほん"},
+		{"日本国", "にほん"},
+		{"上手", "じょうず"},
+	}
+
+	for i, v := range tt {
+		r, _ := d.Lookup(v.s)
+		require.Equal(t, v.r, r, "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestAddEntryPrecedence(t *testing.T) {
+	var d MemoryDictionary
+	d.Add("日本", "やまと")
+	d.AddEntry(DictionaryEntry{
+		Kanji:    []DictionaryForm{{Text: "日本", Priority: []string{"news1"}}},
+		Readings: []DictionaryForm{{Text: "にほん", Priority: []string{"news1"}}},
+	})
+	r, _ := d.Lookup("日本")
+	require.Equal(t, "やまと", r)
+
+	d.AddEntry(DictionaryEntry{
+		Kanji:    []DictionaryForm{{Text: "上手"}},
+		Readings: []DictionaryForm{{Text: "うわて"}},
+	})
+	d.AddEntry(DictionaryEntry{
+		Kanji:    []DictionaryForm{{Text: "上手", Priority: []string{"ichi1"}}},
+		Readings: []DictionaryForm{{Text: "じょうず", Priority: []string{"ichi1"}}},
+	})
+	d.AddEntry(DictionaryEntry{
+		Kanji:    []DictionaryForm{{Text: "上手"}},
+		Readings: []DictionaryForm{{Text: "かみて"}},
+	})
+	r, _ = d.Lookup("上手")
+	require.Equal(t, "じょうず", r)
+}
@@ -0,0 +1,96 @@
+package kana
+
+import "strings"
+
+// KanjiList indicates which official list of kanji a kanji belongs to, as
+// returned by ClassifyKanji.
+type KanjiList uint8
+
+const (
+	// KanjiUnlisted indicates a kanji which is on neither the Jōyō nor the
+	// Jinmeiyō list, or a character which is not kanji.
+	KanjiUnlisted KanjiList = iota
+
+	// KanjiJoyo indicates one of the 2,136 Jōyō (regular use) kanji.
+	KanjiJoyo
+
+	// KanjiJinmeiyo indicates one of the 863 Jinmeiyō kanji, which may be
+	// used in personal names in addition to the Jōyō kanji.
+	KanjiJinmeiyo
+)
+
+// kanjiListNames contains the names of each kanji list, in order.
+var kanjiListNames = []string{"unlisted", "jōyō", "jinmeiyō"}
+
+// String returns the name of the kanji list.
+func (l KanjiList) String() string {
+	if int(l) < len(kanjiListNames) {
+		return kanjiListNames[l]
+	}
+	return kanjiListNames[KanjiUnlisted]
+}
+
+// kanjiLists maps each Jōyō and Jinmeiyō kanji to the list it belongs to.
+var kanjiLists = buildKanjiLists()
+
+// buildKanjiLists builds the kanjiLists lookup from the embedded lists.
+func buildKanjiLists() map[rune]KanjiList {
+	m := make(map[rune]KanjiList, 3000)
+	for _, s := range kyoikuKanji {
+		for _, r := range s {
+			m[r] = KanjiJoyo
+		}
+	}
+	for _, r := range joyoSecondaryKanji {
+		m[r] = KanjiJoyo
+	}
+	for r := range joyoKanjiAliases {
+		m[r] = KanjiJoyo
+	}
+	for _, r := range jinmeiyoKanji {
+		m[r] = KanjiJinmeiyo
+	}
+
+	return m
+}
+
+// ClassifyKanji returns the official list which a kanji belongs to, or
+// KanjiUnlisted if it belongs to neither. The JIS X 0208 forms 叱, 填, 剥 and
+// 頬 are treated as Jōyō kanji, along with the official forms 𠮟, 塡, 剝 and 頰.
+func ClassifyKanji(r rune) KanjiList {
+	return kanjiLists[r]
+}
+
+// IsJoyoKanji returns true if a rune is one of the Jōyō kanji.
+func IsJoyoKanji(r rune) bool {
+	return kanjiLists[r] == KanjiJoyo
+}
+
+// IsJinmeiyoKanji returns true if a rune is one of the Jinmeiyō kanji. Jōyō
+// kanji, which may also be used in names, are not Jinmeiyō kanji.
+func IsJinmeiyoKanji(r rune) bool {
+	return kanjiLists[r] == KanjiJinmeiyo
+}
+
+// ListedKanji is a kanji annotated with the official list it belongs to, as
+// returned by ExtractKanjiListed.
+type ListedKanji struct {
+	Kanji string
+	List  KanjiList
+}
+
+// ExtractKanjiListed returns a slice containing all kanji characters found in
+// a string along with the official list each belongs to, in the order in
+// which they were found, as ExtractKanji. Kanji marks (々, 〆, 〇, 〻) belong
+// to no list, and are skipped.
+func ExtractKanjiListed(s string) []ListedKanji {
+	k := []ListedKanji{}
+	for _, c := range ExtractKanji(s) {
+		r := []rune(c)[0]
+		if strings.ContainsRune(kanjiMarks, r) {
+			continue
+		}
+		k = append(k, ListedKanji{Kanji: c, List: ClassifyKanji(r)})
+	}
+	return k
+}
@@ -0,0 +1,77 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKanjiListCounts(t *testing.T) {
+	counts := map[KanjiList]int{}
+	for r, l := range kanjiLists {
+		if _, ok := joyoKanjiAliases[r]; !ok {
+			counts[l]++
+		}
+	}
+
+	require.Equal(t, 2136, counts[KanjiJoyo])
+	require.Equal(t, 863, counts[KanjiJinmeiyo])
+}
+
+func TestClassifyKanjiList(t *testing.T) {
+	tt := []struct {
+		r rune
+		l KanjiList
+	}{
+		{'日', KanjiJoyo},
+		{'語', KanjiJoyo},
+		{'鬱', KanjiJoyo},
+		{'𠮟', KanjiJoyo},
+		{'叱', KanjiJoyo},
+		{'頰', KanjiJoyo},
+		{'頬', KanjiJoyo},
+		{'翔', KanjiJinmeiyo},
+		{'凛', KanjiJinmeiyo},
+		{'凜', KanjiJinmeiyo},
+		{'巫', KanjiJinmeiyo},
+		{'渾', KanjiJinmeiyo},
+		{'國', KanjiJinmeiyo},
+		{'祐', KanjiJinmeiyo},
+		{'祐', KanjiJinmeiyo},
+		{'鬯', KanjiUnlisted},
+		{'々', KanjiUnlisted},
+		{'あ', KanjiUnlisted},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.l, ClassifyKanji(v.r), "testing (%d) %c = %v", i, v.r, v.l)
+		require.Equal(t, v.l == KanjiJoyo, IsJoyoKanji(v.r), "testing (%d) %c", i, v.r)
+		require.Equal(t, v.l == KanjiJinmeiyo, IsJinmeiyoKanji(v.r), "testing (%d) %c", i, v.r)
+	}
+}
+
+func TestKanjiListString(t *testing.T) {
+	require.Equal(t, "unlisted", KanjiUnlisted.String())
+	require.Equal(t, "jōyō", KanjiJoyo.String())
+	require.Equal(t, "jinmeiyō", KanjiJinmeiyo.String())
+	require.Equal(t, "unlisted", KanjiList(9).String())
+}
+
+func TestExtractKanjiListed(t *testing.T) {
+	tt := []struct {
+		s string
+		r []ListedKanji
+	}{
+		{"翔太は學校に行く", []ListedKanji{
+			{"翔", KanjiJinmeiyo}, {"太", KanjiJoyo}, {"學", KanjiUnlisted},
+			{"校", KanjiJoyo}, {"行", KanjiJoyo},
+		}},
+		{"人々", []ListedKanji{{"人", KanjiJoyo}}},
+		{"佐々木", []ListedKanji{{"佐", KanjiJoyo}, {"木", KanjiJoyo}}},
+		{"ひらがな", []ListedKanji{}},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ExtractKanjiListed(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
@@ -0,0 +1,46 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+// KanjiPolicy is a set of flags indicating which kanji-like characters should
+// be accepted as kanji by IsKanjiRune, in addition to the CJK Unified
+// Ideographs, their extensions, and the CJK Compatibility Ideographs.
+type KanjiPolicy uint8
+
+// KanjiStrict accepts only CJK Unified Ideographs, Extensions A to I, and
+// CJK Compatibility Ideographs.
+const KanjiStrict KanjiPolicy = 0
+
+const (
+	// KanjiAllowMarks accepts the kanji iteration and abbreviation marks
+	// (々, 〆, 〇, 〻).
+	KanjiAllowMarks KanjiPolicy = 1 << iota
+
+	// KanjiAllowRadicals accepts Kangxi radicals and CJK radical supplement
+	// characters.
+	KanjiAllowRadicals
+)
+
+// KanjiDefault is the policy used by IsKanji, ContainsKanji and ExtractKanji,
+// which accepts the kanji iteration and abbreviation marks (々, 〆, 〇, 〻) so
+// that words such as 佐々木 are kanji, but not radicals.
+const KanjiDefault = KanjiAllowMarks
+
+// IsKanjiRune returns true if a rune is a kanji character according to the
+// given policy. The katakana-hiragana prolonged sound mark (ー) is never kanji.
+// IsKanji, ContainsKanji and ExtractKanji use the KanjiDefault policy.
+func IsKanjiRune(r rune, policy KanjiPolicy) bool {
+	switch {
+	case unicode.In(r, kanjiTable):
+		return true
+	case policy&KanjiAllowMarks != 0 && strings.ContainsRune(kanjiMarks, r):
+		return true
+	case policy&KanjiAllowRadicals != 0 && unicode.In(r, kanjiRadicalTable):
+		return true
+	}
+
+	return false
+}
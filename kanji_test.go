@@ -0,0 +1,53 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsKanjiRune(t *testing.T) {
+	tt := []struct {
+		r      rune
+		policy KanjiPolicy
+		ok     bool
+	}{
+		{'水', KanjiStrict, true},         // unified ideograph
+		{'㐀', KanjiStrict, true},         // extension a
+		{0x20000, KanjiStrict, true},     // extension b
+		{0x2A700, KanjiStrict, true},     // extension c
+		{0x2B740, KanjiStrict, true},     // extension d
+		{0x2B820, KanjiStrict, true},     // extension e
+		{0x2CEB0, KanjiStrict, true},     // extension f
+		{0x30000, KanjiStrict, true},     // extension g
+		{0x31350, KanjiStrict, true},     // extension h
+		{'豈', KanjiStrict, true},         // compatibility ideograph
+		{0x2F800, KanjiStrict, true},     // compatibility ideograph supplement
+		{'ー', KanjiStrict, false},        // prolonged sound mark
+		{'ー', KanjiAllowMarks, false},    // prolonged sound mark
+		{'々', KanjiStrict, false},        // iteration mark
+		{'々', KanjiAllowMarks, true},     // iteration mark
+		{'〆', KanjiAllowMarks, true},     // shime
+		{'〇', KanjiAllowMarks, true},     // numeral zero
+		{'〻', KanjiAllowMarks, true},     // vertical iteration mark
+		{'⼈', KanjiStrict, false},        // kangxi radical
+		{'⼈', KanjiAllowMarks, false},    // kangxi radical
+		{'⼈', KanjiAllowRadicals, true},  // kangxi radical
+		{'⺅', KanjiAllowRadicals, true},  // radical supplement
+		{'〡', KanjiAllowRadicals, false}, // hangzhou numeral
+		{'あ', KanjiAllowMarks | KanjiAllowRadicals, false},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.ok, IsKanjiRune(v.r, v.policy), "testing (%d) %c = %v", i, v.r, v.ok)
+	}
+}
+
+func TestClassifyKanji(t *testing.T) {
+	require.Equal(t, ScriptKanji, Classify('水'))
+	require.Equal(t, ScriptKanji, Classify(0x30000))
+	require.Equal(t, ScriptKanjiMark, Classify('々'))
+	require.Equal(t, ScriptKanjiRadical, Classify('⼈'))
+	require.Equal(t, ScriptChoonpu, Classify('ー'))
+	require.Equal(t, "kanji radical", ScriptKanjiRadical.String())
+}
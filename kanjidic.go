@@ -0,0 +1,202 @@
+package kana
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// KanjiInfo contains the KANJIDIC2 data of a single kanji.
+type KanjiInfo struct {
+	// Literal is the kanji, such as 亜.
+	Literal rune
+
+	// Onyomi contains the on'yomi of the kanji in katakana, such as ア.
+	Onyomi []string
+
+	// Kunyomi contains the kun'yomi of the kanji in hiragana, such as つ.ぐ,
+	// where a period separates the okurigana, and a leading or trailing
+	// hyphen marks a prefix or suffix. See SplitKunyomi.
+	Kunyomi []string
+
+	// Nanori contains the readings of the kanji used only in names, in
+	// hiragana.
+	Nanori []string
+
+	// Meanings contains the English meanings of the kanji.
+	Meanings []string
+
+	// Strokes is the stroke count of the kanji.
+	Strokes int
+
+	// Grade is the KANJIDIC2 grade of the kanji: 1 to 6 for Kyōiku kanji, 8
+	// for the remaining Jōyō kanji, 9 and 10 for Jinmeiyō kanji, or 0 for
+	// other kanji. Unlike KanjiGrade, the remaining Jōyō kanji are grade 8.
+	Grade int
+
+	// JLPT is the level of the kanji in the former four level Japanese
+	// Language Proficiency Test, from 4 (easiest) to 1, or 0 if the kanji is
+	// not tested.
+	JLPT int
+
+	// Frequency is the rank of the kanji among the 2,500 kanji most used in
+	// newspapers, or 0 if the kanji is not ranked.
+	Frequency int
+}
+
+// OnyomiHiragana returns the on'yomi of the kanji in hiragana.
+func (k KanjiInfo) OnyomiHiragana() []string {
+	out := make([]string, len(k.Onyomi))
+	for i, r := range k.Onyomi {
+		out[i] = strings.Map(KatakanaToHiragana, r)
+	}
+	return out
+}
+
+// SplitKunyomi splits a KANJIDIC2 kun'yomi into the part written with the
+// kanji and the okurigana which follows it, removing any prefix or suffix
+// markers, such that つ.ぐ becomes つ and ぐ, and -ぶり becomes ぶり.
+func SplitKunyomi(s string) (string, string) {
+	s = strings.Trim(s, "-")
+	if i := strings.Index(s, "."); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+	return s, ""
+}
+
+// KanjiDictionary is an in-memory lookup of KANJIDIC2 data by kanji. The
+// zero value is an empty dictionary ready to use.
+type KanjiDictionary struct {
+	kanji map[rune]KanjiInfo
+}
+
+// kanjidicCharacter is the XML structure of a KANJIDIC2 character.
+type kanjidicCharacter struct {
+	Literal string `xml:"literal"`
+	Misc    struct {
+		Grade     int   `xml:"grade"`
+		Strokes   []int `xml:"stroke_count"`
+		Frequency int   `xml:"freq"`
+		JLPT      int   `xml:"jlpt"`
+	} `xml:"misc"`
+	Readings []struct {
+		Type string `xml:"r_type,attr"`
+		Text string `xml:",chardata"`
+	} `xml:"reading_meaning>rmgroup>reading"`
+	Meanings []struct {
+		Lang string `xml:"m_lang,attr"`
+		Text string `xml:",chardata"`
+	} `xml:"reading_meaning>rmgroup>meaning"`
+	Nanori []string `xml:"reading_meaning>nanori"`
+}
+
+// LoadKanjidic returns a KanjiDictionary of the characters read from a
+// KANJIDIC2 XML file.
+func LoadKanjidic(r io.Reader) (*KanjiDictionary, error) {
+	d := &KanjiDictionary{}
+	err := ReadKanjidic(r, func(k KanjiInfo) error {
+		d.Add(k)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ReadKanjidic reads the characters of a KANJIDIC2 XML file, calling fn with
+// each character in turn. The file is read as a stream, so that the full
+// KANJIDIC2 may be read without holding it in memory. Reading stops at the
+// first error returned by fn.
+func ReadKanjidic(r io.Reader, fn func(KanjiInfo) error) error {
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidDictionary, err)
+		}
+
+		t, ok := tok.(xml.StartElement)
+		if !ok || t.Name.Local != "character" {
+			continue
+		}
+
+		var c kanjidicCharacter
+		if err := dec.DecodeElement(&c, &t); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidDictionary, err)
+		}
+
+		lit, size := utf8.DecodeRuneInString(c.Literal)
+		if size == 0 || size != len(c.Literal) {
+			return fmt.Errorf("%w: invalid literal %q", ErrInvalidDictionary, c.Literal)
+		}
+
+		k := KanjiInfo{
+			Literal:   lit,
+			Nanori:    c.Nanori,
+			Grade:     c.Misc.Grade,
+			JLPT:      c.Misc.JLPT,
+			Frequency: c.Misc.Frequency,
+		}
+		if len(c.Misc.Strokes) > 0 {
+			k.Strokes = c.Misc.Strokes[0] // any further counts are common miscounts.
+		}
+		for _, r := range c.Readings {
+			switch r.Type {
+			case "ja_on":
+				k.Onyomi = append(k.Onyomi, r.Text)
+			case "ja_kun":
+				k.Kunyomi = append(k.Kunyomi, r.Text)
+			}
+		}
+		for _, m := range c.Meanings {
+			if m.Lang == "" || m.Lang == "en" {
+				k.Meanings = append(k.Meanings, m.Text)
+			}
+		}
+
+		if err := fn(k); err != nil {
+			return err
+		}
+	}
+}
+
+// Add adds the data of a kanji to the dictionary, replacing any data already
+// held for the kanji.
+func (d *KanjiDictionary) Add(k KanjiInfo) {
+	if d.kanji == nil {
+		d.kanji = map[rune]KanjiInfo{}
+	}
+	d.kanji[k.Literal] = k
+}
+
+// Len returns the number of kanji in the dictionary.
+func (d *KanjiDictionary) Len() int {
+	return len(d.kanji)
+}
+
+// Lookup returns the data of a kanji, and true if the kanji is in the
+// dictionary.
+func (d *KanjiDictionary) Lookup(r rune) (KanjiInfo, bool) {
+	k, ok := d.kanji[r]
+	return k, ok
+}
+
+// LookupKanji returns the data of each kanji in a slice returned by
+// ExtractKanji, in the same order, skipping any kanji which are not in the
+// dictionary.
+func (d *KanjiDictionary) LookupKanji(kanji []string) []KanjiInfo {
+	out := []KanjiInfo{}
+	for _, s := range kanji {
+		r, _ := utf8.DecodeRuneInString(s)
+		if k, ok := d.kanji[r]; ok {
+			out = append(out, k)
+		}
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+package kana
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testKanjidic = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE kanjidic2 [
+<!ELEMENT kanjidic2 (header,character*)>
+]>
+<kanjidic2>
+<header>
+<file_version>4</file_version>
+<database_version>2023-289</database_version>
+<date_of_creation>2023-10-16</date_of_creation>
+</header>
+<character>
+<literal>亜</literal>
+<codepoint><cp_value cp_type="ucs">4e9c</cp_value></codepoint>
+<radical><rad_value rad_type="classical">7</rad_value></radical>
+<misc>
+<grade>8</grade>
+<stroke_count>7</stroke_count>
+<variant var_type="jis208">1-48-19</variant>
+<freq>1509</freq>
+<jlpt>1</jlpt>
+</misc>
+<reading_meaning>
+<rmgroup>
+<reading r_type="pinyin">ya4</reading>
+<reading r_type="ja_on">ア</reading>
+<reading r_type="ja_kun">つ.ぐ</reading>
+<meaning>Asia</meaning>
+<meaning>rank next</meaning>
+<meaning m_lang="fr">Asie</meaning>
+</rmgroup>
+<nanori>や</nanori>
+<nanori>つぎ</nanori>
+</reading_meaning>
+</character>
+<character>
+<literal>日</literal>
+<misc>
+<grade>1</grade>
+<stroke_count>4</stroke_count>
+<stroke_count>3</stroke_count>
+<freq>1</freq>
+<jlpt>4</jlpt>
+</misc>
+<reading_meaning>
+<rmgroup>
+<reading r_type="ja_on">ニチ</reading>
+<reading r_type="ja_on">ジツ</reading>
+<reading r_type="ja_kun">ひ</reading>
+<reading r_type="ja_kun">-び</reading>
+<reading r_type="ja_kun">-か</reading>
+<meaning>day</meaning>
+<meaning>sun</meaning>
+<meaning>Japan</meaning>
+</rmgroup>
+</reading_meaning>
+</character>
+<character>
+<literal>𠮟</literal>
+<misc>
+<grade>8</grade>
+<stroke_count>5</stroke_count>
+</misc>
+<reading_meaning>
+<rmgroup>
+<reading r_type="ja_on">シツ</reading>
+<reading r_type="ja_kun">しか.る</reading>
+<meaning>scold</meaning>
+</rmgroup>
+</reading_meaning>
+</character>
+</kanjidic2>
+`
+
+func TestLoadKanjidic(t *testing.T) {
+	d, err := LoadKanjidic(strings.NewReader(testKanjidic))
+	require.NoError(t, err)
+	require.Equal(t, 3, d.Len())
+
+	k, ok := d.Lookup('亜')
+	require.True(t, ok)
+	require.Equal(t, KanjiInfo{
+		Literal:   '亜',
+		Onyomi:    []string{"ア"},
+		Kunyomi:   []string{"つ.ぐ"},
+		Nanori:    []string{"や", "つぎ"},
+		Meanings:  []string{"Asia", "rank next"},
+		Strokes:   7,
+		Grade:     8,
+		JLPT:      1,
+		Frequency: 1509,
+	}, k)
+
+	k, ok = d.Lookup('日')
+	require.True(t, ok)
+	require.Equal(t, 4, k.Strokes)
+	require.Equal(t, []string{"にち", "じつ"}, k.OnyomiHiragana())
+	require.Equal(t, "nichi", ToRomaji(k.Onyomi[0], false))
+	require.Equal(t, []string{"ひ", "-び", "-か"}, k.Kunyomi)
+
+	_, ok = d.Lookup('月')
+	require.False(t, ok)
+
+	kanji := d.LookupKanji(ExtractKanji("日本を𠮟る"))
+	require.Len(t, kanji, 2)
+	require.Equal(t, '日', kanji[0].Literal)
+	require.Equal(t, '𠮟', kanji[1].Literal)
+
+	_, err = LoadKanjidic(strings.NewReader("<kanjidic2><character><literal>日本</literal></character></kanjidic2>"))
+	require.True(t, errors.Is(err, ErrInvalidDictionary))
+
+	_, err = LoadKanjidic(strings.NewReader("<kanjidic2><character><literal>日</literal>"))
+	require.True(t, errors.Is(err, ErrInvalidDictionary))
+}
+
+func TestReadKanjidicStop(t *testing.T) {
+	stop := errors.New("stop")
+	n := 0
+	err := ReadKanjidic(strings.NewReader(testKanjidic), func(k KanjiInfo) error {
+		n++
+		return stop
+	})
+	require.Equal(t, stop, err)
+	require.Equal(t, 1, n)
+}
+
+func TestSplitKunyomi(t *testing.T) {
+	tt := []struct {
+		s    string
+		stem string
+		oku  string
+	}{
+		{"つ.ぐ", "つ", "ぐ"},
+		{"しか.る", "しか", "る"},
+		{"ひ", "ひ", ""},
+		{"-び", "び", ""},
+		{"あ.げる", "あ", "げる"},
+		{"なか-", "なか", ""},
+	}
+
+	for i, v := range tt {
+		stem, oku := SplitKunyomi(v.s)
+		require.Equal(t, v.stem, stem, "testing (%d) %s = %v", i, v.s, v.stem)
+		require.Equal(t, v.oku, oku, "testing (%d) %s = %v", i, v.s, v.oku)
+	}
+}
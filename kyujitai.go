@@ -0,0 +1,61 @@
+package kana
+
+import "strings"
+
+// toShinjitai and toKyujitai map kyūjitai (old form) kanji to the shinjitai
+// (new form) which replaced them, and back.
+var toShinjitai, toKyujitai = buildKyujitai()
+
+// buildKyujitai builds the toShinjitai and toKyujitai lookups from the
+// embedded pairs. Compatibility ideographs are only mapped to shinjitai, as
+// they are replaced by their unified ideographs under Unicode normalization.
+func buildKyujitai() (map[rune]rune, map[rune]rune) {
+	shin := make(map[rune]rune, 400)
+	kyu := make(map[rune]rune, 300)
+
+	pairs := []rune(kyujitaiPairs)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		shin[pairs[i+1]] = pairs[i]
+		if _, ok := kyu[pairs[i]]; !ok {
+			kyu[pairs[i]] = pairs[i+1]
+		}
+	}
+
+	pairs = []rune(kyujitaiCompatPairs)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		shin[pairs[i+1]] = pairs[i]
+	}
+
+	return shin, kyu
+}
+
+// ToShinjitai converts all kyūjitai (old form) kanji in a string to their
+// shinjitai (new form) equivalents, such as 國 to 国 and 學 to 学. Other
+// characters are left unchanged.
+func ToShinjitai(s string) string {
+	return strings.Map(func(r rune) rune {
+		if v, ok := toShinjitai[r]; ok {
+			return v
+		}
+		return r
+	}, s)
+}
+
+// ToKyujitai converts all shinjitai (new form) kanji in a string to their
+// kyūjitai (old form) equivalents, such as 国 to 國 and 沢 to 澤. Where a
+// shinjitai replaced several kyūjitai, such as 弁 for 辯, 辨 and 瓣, the most
+// common kyūjitai is used.
+func ToKyujitai(s string) string {
+	return strings.Map(func(r rune) rune {
+		if v, ok := toKyujitai[r]; ok {
+			return v
+		}
+		return r
+	}, s)
+}
+
+// EquivalentKanji returns true if two strings are equal once all kyūjitai
+// have been converted to shinjitai, such that 國學 is equivalent to 国学.
+func EquivalentKanji(a, b string) bool {
+	return ToShinjitai(a) == ToShinjitai(b)
+}
@@ -0,0 +1,67 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToShinjitai(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"國", "国"},
+		{"學校", "学校"},
+		{"澤田", "沢田"},
+		{"舊字體", "旧字体"},
+		{"辯護士", "弁護士"},
+		{"花瓣", "花弁"},
+		{"\ufa45", "海"}, // compatibility ideograph
+		{"ひらがなとカタカナ", "ひらがなとカタカナ"},
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ToShinjitai(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestToKyujitai(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"国", "國"},
+		{"学校", "學校"},
+		{"沢田", "澤田"},
+		{"旧字体", "舊字體"},
+		{"弁護士", "辯護士"},
+		{"海", "海"},
+		{"山と川", "山と川"},
+		{"", ""},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, ToKyujitai(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestEquivalentKanji(t *testing.T) {
+	tt := []struct {
+		a  string
+		b  string
+		ok bool
+	}{
+		{"國學院", "国学院", true},
+		{"澤", "沢", true},
+		{"國", "國", true},
+		{"\ufa45", "海", true},
+		{"国", "因", false},
+		{"國", "くに", false},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.ok, EquivalentKanji(v.a, v.b), "testing (%d) %s %s = %v", i, v.a, v.b, v.ok)
+	}
+}
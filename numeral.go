@@ -0,0 +1,240 @@
+package kana
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidNumeral indicates a string which is not a valid Japanese
+	// numeral.
+	ErrInvalidNumeral = errors.New("invalid japanese numeral")
+
+	// ErrNumeralRange indicates a numeral which is too large to be
+	// represented as an int64.
+	ErrNumeralRange = errors.New("japanese numeral out of range")
+)
+
+// NumeralStyle indicates how an integer is written by FormatKanjiNumber.
+type NumeralStyle uint8
+
+const (
+	// NumeralGrouped writes numbers using kanji digits and units, such as
+	// 二千二十三 or 一万二千.
+	NumeralGrouped NumeralStyle = iota
+
+	// NumeralPositional writes numbers using kanji digits in positional
+	// notation, such as 二〇二三.
+	NumeralPositional
+
+	// NumeralDaiji writes numbers using the daiji (formal numerals) used in
+	// legal and financial documents, such as 弐千弐拾参 or 壱万弐千.
+	NumeralDaiji
+
+	// NumeralArabicGrouped writes numbers using arabic digits for each group
+	// of four digits, with kanji for the large units, such as 2億3456万7890.
+	NumeralArabicGrouped
+)
+
+var (
+	bigTen   = big.NewInt(10)
+	bigGroup = big.NewInt(10000)
+)
+
+// noLargeUnit is the exponent recorded before any large unit has been read
+// by ParseKanjiNumberBig.
+const noLargeUnit = 1 << 30
+
+// ParseKanjiNumber parses a Japanese numeral into an int64. Numerals may be
+// written with kanji digits and units (二千二十三), in positional notation
+// (一〇五), with daiji (壱万), with full-width or arabic digits (２０２３), or a
+// mix of digits and large units (2万3000). A leading minus sign or マイナス
+// indicates a negative number.
+func ParseKanjiNumber(s string) (int64, error) {
+	n, err := ParseKanjiNumberBig(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if !n.IsInt64() {
+		return 0, ErrNumeralRange
+	}
+
+	return n.Int64(), nil
+}
+
+// ParseKanjiNumberBig parses a Japanese numeral into a big.Int, as
+// ParseKanjiNumber, for numerals which may exceed the range of an int64.
+func ParseKanjiNumberBig(s string) (*big.Int, error) {
+	s, neg := trimNumeralSign(s)
+
+	total := new(big.Int)
+	section := 0             // the value of the current group of four digits.
+	num := new(big.Int)      // the digits read since the last unit.
+	digits := 0              // the number of digits in num.
+	lastSmall := 0           // the last small unit in the current group.
+	lastLarge := noLargeUnit // the exponent of the last large unit.
+	for _, r := range s {
+		if d, ok := kanjiDigits[r]; ok {
+			num.Mul(num, bigTen).Add(num, big.NewInt(int64(d)))
+			digits++
+			continue
+		}
+
+		if u, ok := kanjiSmallUnits[r]; ok {
+			if (lastSmall > 0 && u >= lastSmall) || digits > 1 {
+				return nil, ErrInvalidNumeral
+			}
+			if digits == 0 {
+				num.SetInt64(1)
+			}
+			section += int(num.Int64()) * u
+			num.SetInt64(0)
+			digits, lastSmall = 0, u
+			continue
+		}
+
+		if e, ok := kanjiLargeUnits[r]; ok {
+			if e >= lastLarge || (lastSmall > 0 && digits > 1) {
+				return nil, ErrInvalidNumeral
+			}
+			num.Add(num, big.NewInt(int64(section)))
+			if num.Sign() == 0 || num.Cmp(bigGroup) >= 0 {
+				return nil, ErrInvalidNumeral
+			}
+			unit := new(big.Int).Exp(bigTen, big.NewInt(int64(e)), nil)
+			total.Add(total, num.Mul(num, unit))
+			num = new(big.Int)
+			section, digits, lastSmall, lastLarge = 0, 0, 0, e
+			continue
+		}
+
+		if (r == ',' || r == '，') && digits > 0 {
+			continue
+		}
+
+		return nil, ErrInvalidNumeral
+	}
+
+	if digits == 0 && lastSmall == 0 && lastLarge == noLargeUnit {
+		return nil, ErrInvalidNumeral
+	}
+
+	if (lastSmall > 0 && digits > 1) || (lastLarge != noLargeUnit && num.Cmp(bigGroup) >= 0) {
+		return nil, ErrInvalidNumeral
+	}
+
+	total.Add(total, num.Add(num, big.NewInt(int64(section))))
+	if neg {
+		total.Neg(total)
+	}
+
+	return total, nil
+}
+
+// trimNumeralSign removes a leading minus sign or マイナス from a numeral,
+// returning true if it was negative.
+func trimNumeralSign(s string) (string, bool) {
+	for _, p := range []string{"-", "－", "−", "マイナス"} {
+		if strings.HasPrefix(s, p) {
+			return s[len(p):], true
+		}
+	}
+	return s, false
+}
+
+// FormatKanjiNumber formats an integer as a Japanese numeral in the given
+// style.
+func FormatKanjiNumber(n int64, style NumeralStyle) string {
+	return FormatKanjiNumberBig(big.NewInt(n), style)
+}
+
+// FormatKanjiNumberBig formats a big.Int as a Japanese numeral in the given
+// style. Numbers too large to be written with the large units, from 10^52,
+// are written in positional notation.
+func FormatKanjiNumberBig(n *big.Int, style NumeralStyle) string {
+	var sb strings.Builder
+	if n.Sign() < 0 {
+		sb.WriteString("-")
+		n = new(big.Int).Neg(n)
+	}
+
+	limit := new(big.Int).Exp(bigGroup, big.NewInt(int64(len(kanjiLargeUnitNames)+1)), nil)
+	if style == NumeralPositional || n.Cmp(limit) >= 0 {
+		for _, c := range n.String() {
+			sb.WriteString(kanjiDigitNames[c-'0'])
+		}
+		return sb.String()
+	}
+
+	if n.Sign() == 0 {
+		switch style {
+		case NumeralDaiji:
+			return daijiDigitNames[0]
+		case NumeralArabicGrouped:
+			return "0"
+		}
+		return kanjiDigitNames[0]
+	}
+
+	// Split the number into groups of four digits, least significant first.
+	groups := []int{}
+	rem := new(big.Int).Set(n)
+	group := new(big.Int)
+	for rem.Sign() > 0 {
+		rem.DivMod(rem, bigGroup, group)
+		groups = append(groups, int(group.Int64()))
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+
+		sb.WriteString(formatNumeralGroup(groups[i], style))
+		if i > 0 {
+			sb.WriteString(kanjiLargeUnitNames[i-1])
+		}
+	}
+
+	return sb.String()
+}
+
+// formatNumeralGroup formats a group of four digits in the given style. Ones
+// are only written before ten, hundred and thousand in the daiji style, so a
+// group of one followed by a large unit is written as 一万, but 十 is not 一十.
+func formatNumeralGroup(g int, style NumeralStyle) string {
+	if style == NumeralArabicGrouped {
+		return strconv.Itoa(g)
+	}
+
+	digitNames, unitNames := kanjiDigitNames, kanjiSmallUnitNames
+	if style == NumeralDaiji {
+		digitNames, unitNames = daijiDigitNames, daijiSmallUnitNames
+	}
+
+	var sb strings.Builder
+	for e := 3; e >= 0; e-- {
+		d := g / pow10(e) % 10
+		switch {
+		case d == 0:
+			continue
+		case e == 0, d > 1, style == NumeralDaiji:
+			sb.WriteString(digitNames[d])
+		}
+		sb.WriteString(unitNames[e])
+	}
+
+	return sb.String()
+}
+
+// pow10 returns 10 raised to the power of e.
+func pow10(e int) int {
+	n := 1
+	for ; e > 0; e-- {
+		n *= 10
+	}
+	return n
+}
@@ -0,0 +1,114 @@
+package kana
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKanjiNumber(t *testing.T) {
+	tt := []struct {
+		s   string
+		r   int64
+		err error
+	}{
+		{"〇", 0, nil},
+		{"一", 1, nil},
+		{"十", 10, nil},
+		{"十二", 12, nil},
+		{"二十", 20, nil},
+		{"百", 100, nil},
+		{"三百五", 305, nil},
+		{"千", 1000, nil},
+		{"二千二十三", 2023, nil},
+		{"一〇五", 105, nil},
+		{"二〇二三", 2023, nil},
+		{"壱万", 10000, nil},
+		{"弐千弐拾参", 2023, nil},
+		{"参百", 300, nil},
+		{"２０２３", 2023, nil},
+		{"2023", 2023, nil},
+		{"1,234", 1234, nil},
+		{"2万3000", 23000, nil},
+		{"百万", 1000000, nil},
+		{"一千万", 10000000, nil},
+		{"三億五千万", 350000000, nil},
+		{"一兆二億", 1000200000000, nil},
+		{"2億3456万7890", 234567890, nil},
+		{"マイナス五", -5, nil},
+		{"-12", -12, nil},
+		{"九百二十二京三千三百七十二兆三百六十八億五千四百七十七万五千八百七", 9223372036854775807, nil},
+		{"一垓", 0, ErrNumeralRange},
+		{"", 0, ErrInvalidNumeral},
+		{"万", 0, ErrInvalidNumeral},
+		{"十百", 0, ErrInvalidNumeral},
+		{"二十三十", 0, ErrInvalidNumeral},
+		{"一万一億", 0, ErrInvalidNumeral},
+		{"23百", 0, ErrInvalidNumeral},
+		{"千23", 0, ErrInvalidNumeral},
+		{"1万23456", 0, ErrInvalidNumeral},
+		{"にじゅう", 0, ErrInvalidNumeral},
+	}
+
+	for i, v := range tt {
+		r, err := ParseKanjiNumber(v.s)
+		require.Equal(t, v.err, err, "testing (%d) %s", i, v.s)
+		require.Equal(t, v.r, r, "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestParseKanjiNumberBig(t *testing.T) {
+	n, err := ParseKanjiNumberBig("一垓二京")
+	require.NoError(t, err)
+	require.Equal(t, "100020000000000000000", n.String())
+}
+
+func TestFormatKanjiNumber(t *testing.T) {
+	tt := []struct {
+		n     int64
+		style NumeralStyle
+		r     string
+	}{
+		{0, NumeralGrouped, "〇"},
+		{1, NumeralGrouped, "一"},
+		{10, NumeralGrouped, "十"},
+		{11, NumeralGrouped, "十一"},
+		{105, NumeralGrouped, "百五"},
+		{2023, NumeralGrouped, "二千二十三"},
+		{10000, NumeralGrouped, "一万"},
+		{12000, NumeralGrouped, "一万二千"},
+		{10000000, NumeralGrouped, "千万"},
+		{100000001, NumeralGrouped, "一億一"},
+		{-5, NumeralGrouped, "-五"},
+		{2023, NumeralPositional, "二〇二三"},
+		{105, NumeralPositional, "一〇五"},
+		{0, NumeralPositional, "〇"},
+		{2023, NumeralDaiji, "弐千弐拾参"},
+		{12000, NumeralDaiji, "壱万弐千"},
+		{110, NumeralDaiji, "壱百壱拾"},
+		{0, NumeralDaiji, "零"},
+		{234567890, NumeralArabicGrouped, "2億3456万7890"},
+		{10005, NumeralArabicGrouped, "1万5"},
+		{0, NumeralArabicGrouped, "0"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, FormatKanjiNumber(v.n, v.style), "testing (%d) %d = %v", i, v.n, v.r)
+	}
+}
+
+func TestFormatKanjiNumberRoundTrip(t *testing.T) {
+	for _, n := range []int64{0, 7, 10, 19, 100, 1001, 9999, 10000, 10001, 123456789, 9223372036854775807} {
+		for _, style := range []NumeralStyle{NumeralGrouped, NumeralPositional, NumeralDaiji, NumeralArabicGrouped} {
+			r, err := ParseKanjiNumber(FormatKanjiNumber(n, style))
+			require.NoError(t, err)
+			require.Equal(t, n, r, "testing %d in style %d", n, style)
+		}
+	}
+}
+
+func TestFormatKanjiNumberBig(t *testing.T) {
+	n, _ := new(big.Int).SetString("100020000000000000000", 10)
+	require.Equal(t, "一垓二京", FormatKanjiNumberBig(n, NumeralGrouped))
+}
@@ -0,0 +1,100 @@
+package kana
+
+import (
+	"sort"
+	"strings"
+)
+
+// doubledConsonants contains the romaji consonants which may be doubled to
+// indicate a small tsu (っ).
+const doubledConsonants = "bcdfghjklmpqrstvwyz"
+
+// maxRomajiKey is the length of the longest romaji key in romajiHiragana.
+var maxRomajiKey = func() int {
+	n := 0
+	for i := 0; i < len(romajiHiragana); i += 2 {
+		if len(romajiHiragana[i]) > n {
+			n = len(romajiHiragana[i])
+		}
+	}
+	return n
+}()
+
+// RomajiPrefixes returns the sorted set of hiragana strings which a partially
+// typed string of kana or romaji could resolve to, for autocompletion and IME
+// candidate lists. Whereas ToHiragana leaves incomplete romaji unchanged,
+// incomplete romaji at the end of the string is expanded into every kana it
+// could become, such that ky becomes きぇ, きゃ, きゅ and きょ, and shin
+// becomes しん, しな, しに… A single consonant may also begin a doubled
+// consonant, such that t becomes た, つ, て… and っ.
+func RomajiPrefixes(s string) []string {
+	s = strings.ToLower(normalizeWidth(s))
+	set := map[string]bool{}
+	for k := 0; k <= len(s) && k <= maxRomajiKey+1; k++ {
+		if k > 0 && (s[len(s)-k] < 'a' || s[len(s)-k] > 'z') {
+			break // only trailing latin letters may be incomplete romaji.
+		}
+
+		head, tail := s[:len(s)-k], s[len(s)-k:]
+		kana := ToHiragana(head)
+		if !isRomajiComplete(kana) {
+			continue
+		}
+
+		if tail == "" {
+			set[kana] = true
+			continue
+		}
+
+		for _, p := range expandRomaji(tail) {
+			set[kana+p] = true
+		}
+	}
+
+	prefixes := make([]string, 0, len(set))
+	for p := range set {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	return prefixes
+}
+
+// expandRomaji returns the hiragana which an incomplete fragment of romaji
+// could become.
+func expandRomaji(s string) []string {
+	out := []string{}
+	for i := 0; i < len(romajiHiragana); i += 2 {
+		if strings.HasPrefix(romajiHiragana[i], s) {
+			out = append(out, romajiHiragana[i+1])
+		}
+	}
+
+	if strings.IndexByte(doubledConsonants, s[0]) < 0 {
+		return out
+	}
+
+	switch {
+	case len(s) == 1:
+		out = append(out, "っ")
+	case s[1] == s[0] || (s[0] == 't' && s[1] == 'c'):
+		for i := 0; i < len(romajiHiragana); i += 2 {
+			if strings.HasPrefix(romajiHiragana[i], s[1:]) {
+				out = append(out, "っ"+romajiHiragana[i+1])
+			}
+		}
+	}
+
+	return out
+}
+
+// isRomajiComplete returns true if a string converted by ToHiragana contains
+// no remaining latin letters.
+func isRomajiComplete(s string) bool {
+	for _, r := range s {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRomajiPrefixes(t *testing.T) {
+	tt := []struct {
+		s string
+		r []string
+	}{
+		{"shin", []string{"しな", "しに", "しにぇ", "しにゃ", "しにゅ", "しにょ", "しぬ", "しね", "しの", "しん"}},
+		{"sh", []string{"し", "しぇ", "しゃ", "しゅ", "しょ"}},
+		{"ky", []string{"きぇ", "きゃ", "きゅ", "きょ"}},
+		{"kk", []string{"っか", "っき", "っきぇ", "っきゃ", "っきゅ", "っきょ", "っく", "っくぁ", "っくぃ", "っくぅ", "っくぇ", "っくぉ", "っけ", "っこ"}},
+		{"tch", []string{"っち", "っちぇ", "っちゃ", "っちゅ", "っちょ"}},
+		{"kyo", []string{"きょ"}},
+		{"kyoto", []string{"きょと"}},
+		{"t", []string{"た", "ちゅ", "っ", "つ", "つぁ", "つぃ", "つぇ", "つぉ", "つゅ", "て", "てぃ", "と", "とぅ"}},
+		{"n", []string{"な", "に", "にぇ", "にゃ", "にゅ", "にょ", "ぬ", "ね", "の", "ん"}},
+		{"カt", []string{"かた", "かちゅ", "かっ", "かつ", "かつぁ", "かつぃ", "かつぇ", "かつぉ", "かつゅ", "かて", "かてぃ", "かと", "かとぅ"}},
+		{"しn", []string{"しな", "しに", "しにぇ", "しにゃ", "しにゅ", "しにょ", "しぬ", "しね", "しの", "しん"}},
+		{"ＳＨＩ", []string{"し"}},
+		{"x", []string{"っ"}},
+		{"", []string{""}},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, RomajiPrefixes(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
@@ -0,0 +1,145 @@
+package kana
+
+import "strings"
+
+// NumberReadingOptions contains options for reading numbers aloud with
+// NumberToKana and DecimalToKana.
+type NumberReadingOptions struct {
+	// Shi reads a four in the ones place as し rather than よん. Four is
+	// always read as よん in other places, as in よんひゃく.
+	Shi bool
+
+	// Shichi reads seven as しち rather than なな in every place, as in
+	// しちじゅう.
+	Shichi bool
+
+	// Rei reads zero as れい rather than ぜろ.
+	Rei bool
+}
+
+// NumberToKana returns the reading of an integer in hiragana, such as
+// せんにひゃくさんじゅうよん for 1234, including the sound changes of
+// さんびゃく, ろっぴゃく, はっせん and いっちょう. The reading may be passed to
+// ToRomaji.
+func NumberToKana(n int64, opts NumberReadingOptions) string {
+	if n == 0 {
+		return zeroReading(opts)
+	}
+
+	var sb strings.Builder
+	u := uint64(n)
+	if n < 0 {
+		sb.WriteString("まいなす")
+		u = uint64(-n) // the most negative int64 is unchanged, but uint64 holds it.
+	}
+
+	// Split the number into groups of four digits, least significant first.
+	groups := []int{}
+	for ; u > 0; u /= 10000 {
+		groups = append(groups, int(u%10000))
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+
+		g := groupReading(groups[i], i == 0, opts)
+		if i > 0 {
+			unit := numberLargeUnitReadings[i-1]
+			g = soundChange(g, unit) + unit
+		}
+		sb.WriteString(g)
+	}
+
+	return sb.String()
+}
+
+// DecimalToKana returns the reading of a decimal number in hiragana, such as
+// さんてんいちよん for 3.14. The integer part may be any numeral accepted by
+// ParseKanjiNumber, and the digits following the decimal point are read
+// individually. The integer part undergoes the sound change of the decimal
+// point, such that 1.5 is read いってんご.
+func DecimalToKana(s string, opts NumberReadingOptions) (string, error) {
+	whole, frac := s, ""
+	for _, p := range []string{".", "．"} {
+		if i := strings.Index(s, p); i >= 0 {
+			whole, frac = s[:i], s[i+len(p):]
+			if frac == "" {
+				return "", ErrInvalidNumeral
+			}
+			break
+		}
+	}
+
+	n, err := ParseKanjiNumber(whole)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	if _, neg := trimNumeralSign(whole); neg && n == 0 {
+		sb.WriteString("まいなす") // negative numbers between zero and minus one.
+	}
+	reading := NumberToKana(n, opts)
+	if frac == "" {
+		sb.WriteString(reading)
+		return sb.String(), nil
+	}
+
+	sb.WriteString(soundChange(reading, "てん"))
+	sb.WriteString("てん")
+	for _, r := range frac {
+		d, ok := kanjiDigits[r]
+		if !ok {
+			return "", ErrInvalidNumeral
+		}
+		if d == 0 {
+			sb.WriteString(zeroReading(opts))
+			continue
+		}
+		sb.WriteString(groupReading(d, true, opts))
+	}
+
+	return sb.String(), nil
+}
+
+// groupReading returns the reading of a group of four digits. Four is read
+// as し only in the ones place of the final group.
+func groupReading(g int, last bool, opts NumberReadingOptions) string {
+	var sb strings.Builder
+	for e := 3; e >= 0; e-- {
+		d := g / pow10(e) % 10
+		r := numberReadings[e][d]
+		switch {
+		case d == 7 && opts.Shichi:
+			r = strings.Replace(r, "なな", "しち", 1)
+		case d == 4 && e == 0 && last && opts.Shi:
+			r = "し"
+		}
+		sb.WriteString(r)
+	}
+
+	return sb.String()
+}
+
+// soundChange returns the reading of a number with the sound change of its
+// ending when followed by the given unit, from numberUnitSoundChanges, such
+// that いち becomes いっ before ちょう.
+func soundChange(reading, unit string) string {
+	changes := numberUnitSoundChanges[unit]
+	for j := 0; j+1 < len(changes); j += 2 {
+		if strings.HasSuffix(reading, changes[j]) {
+			return strings.TrimSuffix(reading, changes[j]) + changes[j+1]
+		}
+	}
+	return reading
+}
+
+// zeroReading returns the reading of zero.
+func zeroReading(opts NumberReadingOptions) string {
+	if opts.Rei {
+		return "れい"
+	}
+	return "ぜろ"
+}
@@ -0,0 +1,90 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberToKana(t *testing.T) {
+	tt := []struct {
+		n    int64
+		opts NumberReadingOptions
+		r    string
+	}{
+		{0, NumberReadingOptions{}, "ぜろ"},
+		{0, NumberReadingOptions{Rei: true}, "れい"},
+		{1, NumberReadingOptions{}, "いち"},
+		{4, NumberReadingOptions{}, "よん"},
+		{4, NumberReadingOptions{Shi: true}, "し"},
+		{7, NumberReadingOptions{}, "なな"},
+		{7, NumberReadingOptions{Shichi: true}, "しち"},
+		{10, NumberReadingOptions{}, "じゅう"},
+		{14, NumberReadingOptions{Shi: true}, "じゅうし"},
+		{44, NumberReadingOptions{Shi: true}, "よんじゅうし"},
+		{70, NumberReadingOptions{Shichi: true}, "しちじゅう"},
+		{100, NumberReadingOptions{}, "ひゃく"},
+		{300, NumberReadingOptions{}, "さんびゃく"},
+		{600, NumberReadingOptions{}, "ろっぴゃく"},
+		{800, NumberReadingOptions{}, "はっぴゃく"},
+		{1000, NumberReadingOptions{}, "せん"},
+		{3000, NumberReadingOptions{}, "さんぜん"},
+		{8000, NumberReadingOptions{}, "はっせん"},
+		{1234, NumberReadingOptions{}, "せんにひゃくさんじゅうよん"},
+		{10000, NumberReadingOptions{}, "いちまん"},
+		{40004, NumberReadingOptions{Shi: true}, "よんまんし"},
+		{10000000, NumberReadingOptions{}, "せんまん"},
+		{100000000, NumberReadingOptions{}, "いちおく"},
+		{1000000000000, NumberReadingOptions{}, "いっちょう"},
+		{8000000000000, NumberReadingOptions{}, "はっちょう"},
+		{10000000000000, NumberReadingOptions{}, "じゅっちょう"},
+		{3000000000000, NumberReadingOptions{}, "さんちょう"},
+		{10000000000000000, NumberReadingOptions{}, "いっけい"},
+		{60000000000000000, NumberReadingOptions{}, "ろっけい"},
+		{-5, NumberReadingOptions{}, "まいなすご"},
+		{-9223372036854775808, NumberReadingOptions{}, "まいなすきゅうひゃくにじゅうにけいさんぜんさんびゃくななじゅうにちょうさんびゃくろくじゅうはちおくごせんよんひゃくななじゅうななまんごせんはっぴゃくはち"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, NumberToKana(v.n, v.opts), "testing (%d) %d = %v", i, v.n, v.r)
+	}
+}
+
+func TestNumberToKanaRomaji(t *testing.T) {
+	require.Equal(t, "sanbyaku", ToRomaji(NumberToKana(300, NumberReadingOptions{}), false))
+}
+
+func TestDecimalToKana(t *testing.T) {
+	tt := []struct {
+		s    string
+		opts NumberReadingOptions
+		r    string
+		err  error
+	}{
+		{"3.14", NumberReadingOptions{}, "さんてんいちよん", nil},
+		{"3.14", NumberReadingOptions{Shi: true}, "さんてんいちし", nil},
+		{"0.05", NumberReadingOptions{}, "ぜろてんぜろご", nil},
+		{"0.05", NumberReadingOptions{Rei: true}, "れいてんれいご", nil},
+		{"-0.5", NumberReadingOptions{}, "まいなすぜろてんご", nil},
+		{"-1.5", NumberReadingOptions{}, "まいなすいってんご", nil},
+		{"1.5", NumberReadingOptions{}, "いってんご", nil},
+		{"6.5", NumberReadingOptions{}, "ろってんご", nil},
+		{"8.5", NumberReadingOptions{}, "はってんご", nil},
+		{"10.5", NumberReadingOptions{}, "じゅってんご", nil},
+		{"21.5", NumberReadingOptions{}, "にじゅういってんご", nil},
+		{"2.5", NumberReadingOptions{}, "にてんご", nil},
+		{"1", NumberReadingOptions{}, "いち", nil},
+		{"１２．５", NumberReadingOptions{}, "じゅうにてんご", nil},
+		{"42", NumberReadingOptions{}, "よんじゅうに", nil},
+		{"三.五", NumberReadingOptions{}, "さんてんご", nil},
+		{"3.", NumberReadingOptions{}, "", ErrInvalidNumeral},
+		{"3.1a", NumberReadingOptions{}, "", ErrInvalidNumeral},
+		{"abc", NumberReadingOptions{}, "", ErrInvalidNumeral},
+	}
+
+	for i, v := range tt {
+		r, err := DecimalToKana(v.s, v.opts)
+		require.Equal(t, v.err, err, "testing (%d) %s", i, v.s)
+		require.Equal(t, v.r, r, "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
@@ -0,0 +1,194 @@
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Script is a set of flags describing the script and form of a character,
+// as returned by Classify. A character may have more than one flag set, such
+// that a half-width small katakana character is classified as
+// ScriptKatakana | ScriptSmallKana | ScriptHalfWidth.
+type Script uint16
+
+// ScriptOther indicates a character which is not otherwise classified, such
+// as whitespace, symbols, or characters of other languages.
+const ScriptOther Script = 0
+
+const (
+	// ScriptHiragana indicates a hiragana character, including the hiragana
+	// iteration marks (ゝ, ゞ) and hentaigana.
+	ScriptHiragana Script = 1 << iota
+
+	// ScriptKatakana indicates a katakana character, including the katakana
+	// iteration marks (ヽ, ヾ) and half-width katakana.
+	ScriptKatakana
+
+	// ScriptSmallKana indicates a small hiragana or katakana character, such
+	// as ぁ, ッ or ㇰ.
+	ScriptSmallKana
+
+	// ScriptKanji indicates a kanji character.
+	ScriptKanji
+
+	// ScriptKanjiMark indicates a kanji iteration or abbreviation mark which
+	// is used in place of kanji (々, 〆, 〇, 〻).
+	ScriptKanjiMark
+
+	// ScriptChoonpu indicates a katakana-hiragana prolonged sound mark (ー).
+	ScriptChoonpu
+
+	// ScriptPunctuation indicates a Japanese punctuation mark, such as 、, 。,
+	// 「, or ・, or a full-width equivalent of ASCII punctuation.
+	ScriptPunctuation
+
+	// ScriptLatin indicates a latin letter, including full-width latin.
+	ScriptLatin
+
+	// ScriptDigit indicates an arabic numeral, including full-width numerals.
+	ScriptDigit
+
+	// ScriptHalfWidth indicates a half-width form of a character, such as ｶ.
+	ScriptHalfWidth
+
+	// ScriptFullWidth indicates a full-width form of a character, such as Ａ.
+	ScriptFullWidth
+
+	// ScriptKanjiRadical indicates a Kangxi radical or CJK radical supplement
+	// character, such as ⼈, which resembles but is not itself a kanji.
+	ScriptKanjiRadical
+)
+
+// scriptNames contains the names of each script flag, in order of the flags.
+var scriptNames = []string{
+	"hiragana",
+	"katakana",
+	"small kana",
+	"kanji",
+	"kanji mark",
+	"choonpu",
+	"punctuation",
+	"latin",
+	"digit",
+	"half-width",
+	"full-width",
+	"kanji radical",
+}
+
+// String returns the names of the script flags which are set, separated by
+// pipes, or "other" if none are set.
+func (s Script) String() string {
+	if s == ScriptOther {
+		return "other"
+	}
+
+	names := []string{}
+	for i, name := range scriptNames {
+		if s&(ScriptHiragana<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Is returns true if any of the script flags of t are set in s.
+func (s Script) Is(t Script) bool {
+	return s&t != 0
+}
+
+// Classify returns the script flags which describe a single character.
+func Classify(r rune) Script {
+	var s Script
+	switch {
+	case r < 0x80:
+		switch {
+		case r >= '0' && r <= '9':
+			return ScriptDigit
+		case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			return ScriptLatin
+		}
+		return ScriptOther
+	case unicode.In(r, unicode.Hiragana, kanaExtendedHiragana):
+		s = ScriptHiragana
+	case unicode.In(r, unicode.Katakana, kanaExtendedKatakana):
+		s = ScriptKatakana
+	case unicode.In(r, kanjiTable):
+		return ScriptKanji
+	case strings.ContainsRune(kanjiMarks, r):
+		return ScriptKanjiMark
+	case unicode.In(r, kanjiRadicalTable):
+		return ScriptKanjiRadical
+	case r == 'ー':
+		return ScriptChoonpu
+	case r == 'ｰ':
+		return ScriptChoonpu | ScriptHalfWidth
+	case isJapanesePunctuation(r):
+		s = ScriptPunctuation
+	case r >= '０' && r <= '９':
+		return ScriptDigit | ScriptFullWidth
+	case unicode.In(r, unicode.Latin):
+		s = ScriptLatin
+	}
+
+	if isSmallKana(r) {
+		s |= ScriptSmallKana
+	}
+
+	switch {
+	case r >= 0xff61 && r <= 0xffdc, r >= 0xffe8 && r <= 0xffee:
+		s |= ScriptHalfWidth
+	case r >= 0xff01 && r <= 0xff60, r >= 0xffe0 && r <= 0xffe6:
+		s |= ScriptFullWidth
+	}
+
+	return s
+}
+
+// isJapanesePunctuation returns true if a rune is a Japanese punctuation
+// mark, or a full-width or half-width form of punctuation.
+func isJapanesePunctuation(r rune) bool {
+	switch {
+	case r >= 0x3001 && r <= 0x3004, // 、。〃〄
+		r >= 0x3008 && r <= 0x3020, // brackets, postal marks and wave dashes.
+		r == 0x3030, r == 0x303d,   // 〰〽
+		r == 0x30a0, r == 0x30fb, // ゠・
+		r >= 0xff01 && r <= 0xff0f, // full-width ASCII punctuation.
+		r >= 0xff1a && r <= 0xff20,
+		r >= 0xff3b && r <= 0xff40,
+		r >= 0xff5b && r <= 0xff65: // full-width brackets and half-width punctuation.
+		return true
+	}
+
+	return false
+}
+
+// isSmallKana returns true if a rune is a small hiragana or katakana.
+func isSmallKana(r rune) bool {
+	if _, ok := smallKana[r]; ok {
+		return true
+	}
+
+	switch {
+	case r >= 'ㇰ' && r <= 'ㇿ',
+		r >= 'ｧ' && r <= 'ｯ',
+		r == 0x1b132, r == 0x1b155,
+		r >= 0x1b150 && r <= 0x1b152,
+		r >= 0x1b164 && r <= 0x1b167:
+		return true
+	}
+
+	return false
+}
+
+// containsAny returns true if any character of a string matches any of the
+// script flags of t.
+func containsAny(s string, t Script) bool {
+	for _, r := range s {
+		if Classify(r).Is(t) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,68 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	tt := []struct {
+		r rune
+		s Script
+	}{
+		{'あ', ScriptHiragana},
+		{'ゝ', ScriptHiragana},
+		{'ぁ', ScriptHiragana | ScriptSmallKana},
+		{'っ', ScriptHiragana | ScriptSmallKana},
+		{0x1B002, ScriptHiragana}, // hentaigana
+		{'ア', ScriptKatakana},
+		{'ヽ', ScriptKatakana},
+		{'ッ', ScriptKatakana | ScriptSmallKana},
+		{'ㇰ', ScriptKatakana | ScriptSmallKana},
+		{'ｶ', ScriptKatakana | ScriptHalfWidth},
+		{'ｯ', ScriptKatakana | ScriptSmallKana | ScriptHalfWidth},
+		{'水', ScriptKanji},
+		{'々', ScriptKanjiMark},
+		{'〆', ScriptKanjiMark},
+		{'〇', ScriptKanjiMark},
+		{'〻', ScriptKanjiMark},
+		{'ー', ScriptChoonpu},
+		{'ｰ', ScriptChoonpu | ScriptHalfWidth},
+		{'、', ScriptPunctuation},
+		{'。', ScriptPunctuation},
+		{'「', ScriptPunctuation},
+		{'・', ScriptPunctuation},
+		{'！', ScriptPunctuation | ScriptFullWidth},
+		{'｡', ScriptPunctuation | ScriptHalfWidth},
+		{'ａ', ScriptLatin | ScriptFullWidth},
+		{'Ｚ', ScriptLatin | ScriptFullWidth},
+		{'a', ScriptLatin},
+		{'é', ScriptLatin},
+		{'1', ScriptDigit},
+		{'１', ScriptDigit | ScriptFullWidth},
+		{' ', ScriptOther},
+		{'　', ScriptOther},
+		{'!', ScriptOther},
+		{'한', ScriptOther},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.s, Classify(v.r), "testing (%d) %c = %v", i, v.r, v.s)
+	}
+}
+
+func TestScriptString(t *testing.T) {
+	require.Equal(t, "other", ScriptOther.String())
+	require.Equal(t, "hiragana", ScriptHiragana.String())
+	require.Equal(t, "katakana|small kana|half-width", (ScriptKatakana | ScriptSmallKana | ScriptHalfWidth).String())
+	require.Equal(t, "full-width", ScriptFullWidth.String())
+}
+
+func TestScriptIs(t *testing.T) {
+	require.Equal(t, true, Classify('ッ').Is(ScriptKatakana))
+	require.Equal(t, true, Classify('ッ').Is(ScriptSmallKana))
+	require.Equal(t, true, Classify('ッ').Is(ScriptHiragana|ScriptKatakana))
+	require.Equal(t, false, Classify('ッ').Is(ScriptHiragana))
+	require.Equal(t, false, ScriptOther.Is(ScriptOther))
+}
@@ -2,24 +2,26 @@ package kana
 
 import (
 	"strings"
+	"time"
+	"unicode"
 )
 
-// sanitizeIsChecks removes certain characters which one would expect
+// sanitizeIsChecks ignores certain characters which one would expect
 // to find in a hiragana or katakana string but don't meaningfully
 // contribute to validation, such as spaces and katakana-hiragana prolonged sound mark.
-var sanitizeIsChecks = strings.NewReplacer(
-	"　", "", // ideographic spaces (0x3000) are removed.
-	" ", "", // spaces (0x20) are removed.
-	"ー", "", // katakana-hiragana prolonged sound mark (0x30FC).
-)
+var sanitizeIsChecks = ValidateOptions{
+	Ignore: "　" + // ideographic spaces (0x3000) are ignored.
+		" " + // spaces (0x20) are ignored.
+		"ー", // katakana-hiragana prolonged sound mark (0x30FC).
+}
 
-// sanitizeIsChecksKanji removes certain characters which one would expect
+// sanitizeIsChecksKanji ignores certain characters which one would expect
 // to find in a kanji string. In this case, only spaces, as katakana-hiragana
-//  prolonged sound marks (0x30FC) as technically kanji.
-var sanitizeIsChecksKanji = strings.NewReplacer(
-	"　", "", // ideographic spaces (0x3000) are removed.
-	" ", "", // spaces (0x20) are removed.
-)
+// prolonged sound marks (0x30FC) are not kanji and should fail validation.
+var sanitizeIsChecksKanji = ValidateOptions{
+	Ignore: "　" + // ideographic spaces (0x3000) are ignored.
+		" ", // spaces (0x20) are ignored.
+}
 
 // phoneticRomaji replaces kana with romaji characters closer matching their
 // phonetic pronunciation.
@@ -212,7 +214,11 @@ var moraicNRomaji = strings.NewReplacer(
 // romajiToHiragana is a Replacer which maps romaji keys to katakana characters.
 // In order to allow case sensitive replacements within ToKana, the romaji
 // keys are all lowercase.
-var romajiToHiragana = strings.NewReplacer(
+var romajiToHiragana = strings.NewReplacer(romajiHiragana...)
+
+// romajiHiragana contains the romaji keys and hiragana values of
+// romajiToHiragana, which are also used to expand incomplete romaji.
+var romajiHiragana = []string{
 	"ka", "か",
 	"ki", "き",
 	"ku", "く",
@@ -389,7 +395,7 @@ var romajiToHiragana = strings.NewReplacer(
 	"e", "え",
 	"o", "お",
 	"n", "ん",
-)
+}
 
 // romajiToKatakana is a Replacer which maps romaji keys to katakana characters.
 // In order to allow case sensitive replacements within ToKana, the romaji
@@ -733,6 +739,7 @@ var kanaToRomaji = strings.NewReplacer(
 	"ぺ", "pe",
 	"ぽ", "po",
 	"ゐ", "wi",
+	"ゑ", "we",
 	"あ", "a",
 	"い", "i",
 	"う", "u",
@@ -900,6 +907,8 @@ var kanaToRomaji = strings.NewReplacer(
 	"ペ", "PE",
 	"ポ", "PO",
 	"ウィ", "WI",
+	"ヰ", "WI",
+	"ヱ", "WE",
 	"ア", "A",
 	"イ", "I",
 	"ウ", "U",
@@ -912,3 +921,712 @@ var kanaToRomaji = strings.NewReplacer(
 	// "ェ", "XE", // Handled by Phonetic functions
 	// "ォ", "XO", // Handled by Phonetic functions
 )
+
+// voicedKana maps unvoiced kana to their voiced (dakuten) equivalents.
+var voicedKana = map[rune]rune{
+	'か': 'が', 'き': 'ぎ', 'く': 'ぐ', 'け': 'げ', 'こ': 'ご',
+	'さ': 'ざ', 'し': 'じ', 'す': 'ず', 'せ': 'ぜ', 'そ': 'ぞ',
+	'た': 'だ', 'ち': 'ぢ', 'つ': 'づ', 'て': 'で', 'と': 'ど',
+	'は': 'ば', 'ひ': 'び', 'ふ': 'ぶ', 'へ': 'べ', 'ほ': 'ぼ',
+	'う': 'ゔ', 'ゝ': 'ゞ',
+	'カ': 'ガ', 'キ': 'ギ', 'ク': 'グ', 'ケ': 'ゲ', 'コ': 'ゴ',
+	'サ': 'ザ', 'シ': 'ジ', 'ス': 'ズ', 'セ': 'ゼ', 'ソ': 'ゾ',
+	'タ': 'ダ', 'チ': 'ヂ', 'ツ': 'ヅ', 'テ': 'デ', 'ト': 'ド',
+	'ハ': 'バ', 'ヒ': 'ビ', 'フ': 'ブ', 'ヘ': 'ベ', 'ホ': 'ボ',
+	'ウ': 'ヴ', 'ワ': 'ヷ', 'ヰ': 'ヸ', 'ヱ': 'ヹ', 'ヲ': 'ヺ', 'ヽ': 'ヾ',
+}
+
+// semivoicedKana maps unvoiced kana to their semi-voiced (handakuten) equivalents.
+var semivoicedKana = map[rune]rune{
+	'は': 'ぱ', 'ひ': 'ぴ', 'ふ': 'ぷ', 'へ': 'ぺ', 'ほ': 'ぽ',
+	'ハ': 'パ', 'ヒ': 'ピ', 'フ': 'プ', 'ヘ': 'ペ', 'ホ': 'ポ',
+}
+
+// unvoicedKana maps voiced and semi-voiced kana back to their unvoiced forms.
+var unvoicedKana = invertRuneMaps(voicedKana, semivoicedKana)
+
+// smallKana maps small kana to their full size equivalents.
+var smallKana = map[rune]rune{
+	'ぁ': 'あ', 'ぃ': 'い', 'ぅ': 'う', 'ぇ': 'え', 'ぉ': 'お',
+	'っ': 'つ', 'ゃ': 'や', 'ゅ': 'ゆ', 'ょ': 'よ', 'ゎ': 'わ', 'ゕ': 'か', 'ゖ': 'け',
+	'ァ': 'ア', 'ィ': 'イ', 'ゥ': 'ウ', 'ェ': 'エ', 'ォ': 'オ',
+	'ッ': 'ツ', 'ャ': 'ヤ', 'ュ': 'ユ', 'ョ': 'ヨ', 'ヮ': 'ワ', 'ヵ': 'カ', 'ヶ': 'ケ',
+}
+
+// invertRuneMaps returns a single map containing the values of each of the
+// given maps keyed to their original keys.
+func invertRuneMaps(m ...map[rune]rune) map[rune]rune {
+	inv := map[rune]rune{}
+	for _, v := range m {
+		for k, r := range v {
+			inv[r] = k
+		}
+	}
+	return inv
+}
+
+// kanaVowels lists the hiragana which end in each of the hiragana vowels,
+// used to resolve chōonpu (ー) into the vowel of the preceding mora.
+var kanaVowels = map[rune]string{
+	'あ': "あぁかがさざただなはばぱまやゃらわゎゕ",
+	'い': "いぃきぎしじちぢにひびぴみりゐ",
+	'う': "うぅくぐすずつづぬふぶぷむゆゅるゔ",
+	'え': "えぇけげせぜてでねへべぺめれゑゖ",
+	'お': "おぉこごそぞとどのほぼぽもよょろを",
+}
+
+// historicalHaRow maps the は-row kana to the わ-row kana they are read as
+// when found within a word in historical kana orthography.
+var historicalHaRow = map[rune]rune{
+	'は': 'わ', 'ひ': 'い', 'ふ': 'う', 'へ': 'え', 'ほ': 'お',
+}
+
+// historicalVerbFollowers contains the nouns and particles which commonly
+// follow a verb ending in ふ within a run of kana, such as こと in いふこと.
+var historicalVerbFollowers = []string{
+	"こと", "もの", "を", "が", "に", "は", "も", "の", "ぞ", "か", "や",
+}
+
+// historicalARow maps あ-row kana which, when followed by う in historical
+// kana orthography, are read as the equivalent お-row kana (かう→こう).
+var historicalARow = map[rune]string{
+	'あ': "お", 'か': "こ", 'が': "ご", 'さ': "そ", 'ざ': "ぞ", 'た': "と", 'だ': "ど",
+	'な': "の", 'は': "ほ", 'ば': "ぼ", 'ぱ': "ぽ", 'ま': "も", 'や': "よ", 'ら': "ろ", 'わ': "お",
+}
+
+// historicalIRow maps い-row kana which, when followed by う in historical
+// kana orthography, are read with a small ゅ (きう→きゅう).
+var historicalIRow = map[rune]string{
+	'い': "ゆ", 'き': "きゅ", 'ぎ': "ぎゅ", 'し': "しゅ", 'じ': "じゅ", 'ち': "ちゅ", 'ぢ': "ぢゅ",
+	'に': "にゅ", 'ひ': "ひゅ", 'び': "びゅ", 'ぴ': "ぴゅ", 'み': "みゅ", 'り': "りゅ",
+}
+
+// historicalERow maps え-row kana which, when followed by う in historical
+// kana orthography, are read as the い-row kana with a small ょ (けう→きょう).
+var historicalERow = map[rune]string{
+	'え': "よ", 'け': "きょ", 'げ': "ぎょ", 'せ': "しょ", 'ぜ': "じょ", 'て': "ちょ", 'で': "ぢょ",
+	'ね': "にょ", 'へ': "ひょ", 'べ': "びょ", 'ぺ': "ぴょ", 'め': "みょ", 'れ': "りょ",
+}
+
+// historicalKana replaces obsolete kana with their modern equivalents.
+var historicalKana = strings.NewReplacer(
+	"ゐ", "い",
+	"ゑ", "え",
+	"くわ", "か", // kwa and gwa are read as ka and ga.
+	"ぐわ", "が",
+)
+
+// macronRomaji replaces long vowels in romaji with their macron equivalents,
+// as used in modified hepburn romanization.
+var macronRomaji = strings.NewReplacer(
+	"aa", "ā",
+	"uu", "ū",
+	"ee", "ē",
+	"oo", "ō",
+	"ou", "ō",
+	"AA", "Ā",
+	"UU", "Ū",
+	"EE", "Ē",
+	"OO", "Ō",
+	"OU", "Ō",
+)
+
+// kanaExtendedHiragana contains hiragana from the Kana Supplement, Kana
+// Extended-A and Small Kana Extension blocks, which are not present in the
+// unicode.Hiragana table of older versions of Go.
+var kanaExtendedHiragana = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{Lo: 0x1b001, Hi: 0x1b11f, Stride: 1},
+		{Lo: 0x1b132, Hi: 0x1b132, Stride: 1},
+		{Lo: 0x1b150, Hi: 0x1b152, Stride: 1},
+	},
+}
+
+// kanaExtendedKatakana contains katakana from the Katakana Phonetic Extensions,
+// Kana Extended-B, Kana Supplement, Kana Extended-A and Small Kana Extension
+// blocks, which are not present in the unicode.Katakana table of older
+// versions of Go.
+var kanaExtendedKatakana = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x31f0, Hi: 0x31ff, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1aff0, Hi: 0x1aff3, Stride: 1},
+		{Lo: 0x1aff5, Hi: 0x1affb, Stride: 1},
+		{Lo: 0x1affd, Hi: 0x1affe, Stride: 1},
+		{Lo: 0x1b000, Hi: 0x1b000, Stride: 1},
+		{Lo: 0x1b120, Hi: 0x1b122, Stride: 1},
+		{Lo: 0x1b155, Hi: 0x1b155, Stride: 1},
+		{Lo: 0x1b164, Hi: 0x1b167, Stride: 1},
+	},
+}
+
+// hentaigana maps ranges of hentaigana (U+1B002-U+1B11E) to the modern hiragana
+// they represent. Hentaigana with more than one reading are mapped to the
+// first reading given in their unicode name, such that HENTAIGANA LETTER KA-KE
+// is mapped to か.
+var hentaigana = []struct {
+	lo, hi rune
+	kana   string
+}{
+	{0x1b002, 0x1b005, "あ"},
+	{0x1b006, 0x1b009, "い"},
+	{0x1b00a, 0x1b00e, "う"},
+	{0x1b00f, 0x1b013, "え"},
+	{0x1b014, 0x1b016, "お"},
+	{0x1b017, 0x1b022, "か"},
+	{0x1b023, 0x1b02a, "き"},
+	{0x1b02b, 0x1b031, "く"},
+	{0x1b032, 0x1b037, "け"},
+	{0x1b038, 0x1b03b, "こ"},
+	{0x1b03c, 0x1b043, "さ"},
+	{0x1b044, 0x1b049, "し"},
+	{0x1b04a, 0x1b051, "す"},
+	{0x1b052, 0x1b056, "せ"},
+	{0x1b057, 0x1b05d, "そ"},
+	{0x1b05e, 0x1b061, "た"},
+	{0x1b062, 0x1b068, "ち"},
+	{0x1b069, 0x1b06d, "つ"},
+	{0x1b06e, 0x1b076, "て"},
+	{0x1b077, 0x1b07d, "と"},
+	{0x1b07e, 0x1b086, "な"},
+	{0x1b087, 0x1b08e, "に"},
+	{0x1b08f, 0x1b091, "ぬ"},
+	{0x1b092, 0x1b098, "ね"},
+	{0x1b099, 0x1b09d, "の"},
+	{0x1b09e, 0x1b0a8, "は"},
+	{0x1b0a9, 0x1b0af, "ひ"},
+	{0x1b0b0, 0x1b0b2, "ふ"},
+	{0x1b0b3, 0x1b0b9, "へ"},
+	{0x1b0ba, 0x1b0c1, "ほ"},
+	{0x1b0c2, 0x1b0c8, "ま"},
+	{0x1b0c9, 0x1b0cf, "み"},
+	{0x1b0d0, 0x1b0d3, "む"},
+	{0x1b0d4, 0x1b0d6, "め"},
+	{0x1b0d7, 0x1b0dc, "も"},
+	{0x1b0dd, 0x1b0e2, "や"},
+	{0x1b0e3, 0x1b0e6, "ゆ"},
+	{0x1b0e7, 0x1b0ec, "よ"},
+	{0x1b0ed, 0x1b0f0, "ら"},
+	{0x1b0f1, 0x1b0f7, "り"},
+	{0x1b0f8, 0x1b0fd, "る"},
+	{0x1b0fe, 0x1b101, "れ"},
+	{0x1b102, 0x1b107, "ろ"},
+	{0x1b108, 0x1b10c, "わ"},
+	{0x1b10d, 0x1b111, "ゐ"},
+	{0x1b112, 0x1b115, "ゑ"},
+	{0x1b116, 0x1b11c, "を"},
+	{0x1b11d, 0x1b11e, "ん"},
+}
+
+// extendedKana maps archaic kana, extended small kana, and katakana phonetic
+// extensions to their modern kana equivalents.
+var extendedKana = map[rune]string{
+	0x1b000: "エ",  // katakana letter archaic e.
+	0x1b001: "いぇ", // hiragana letter archaic ye.
+	0x1b11f: "うぅ", // hiragana letter archaic wu.
+	0x1b120: "イィ", // katakana letter archaic yi.
+	0x1b121: "イェ", // katakana letter archaic ye.
+	0x1b122: "ウゥ", // katakana letter archaic wu.
+	0x1b132: "こ",  // hiragana letter small ko.
+	0x1b150: "ゐ",  // hiragana letter small wi.
+	0x1b151: "ゑ",  // hiragana letter small we.
+	0x1b152: "を",  // hiragana letter small wo.
+	0x1b155: "コ",  // katakana letter small ko.
+	0x1b164: "ヰ",  // katakana letter small wi.
+	0x1b165: "ヱ",  // katakana letter small we.
+	0x1b166: "ヲ",  // katakana letter small wo.
+	0x1b167: "ン",  // katakana letter small n.
+	'ㇰ':     "ク",
+	'ㇱ':     "シ",
+	'ㇲ':     "ス",
+	'ㇳ':     "ト",
+	'ㇴ':     "ヌ",
+	'ㇵ':     "ハ",
+	'ㇶ':     "ヒ",
+	'ㇷ':     "フ",
+	'ㇸ':     "ヘ",
+	'ㇹ':     "ホ",
+	'ㇺ':     "ム",
+	'ㇻ':     "ラ",
+	'ㇼ':     "リ",
+	'ㇽ':     "ル",
+	'ㇾ':     "レ",
+	'ㇿ':     "ロ",
+}
+
+// katakanaToAinu is a Replacer which maps Ainu katakana to Ainu romaji. Final
+// y and w, which are written as full size イ and ウ, are resolved before the
+// replacements are made.
+var katakanaToAinu = strings.NewReplacer(
+	"ㇷ゚", "p", // small fu with combining handakuten (0x309A) is a final p.
+	"ト゚", "tu",
+	"ツ゚", "tu",
+	"セ゚", "ce",
+	"ㇷ゜", "p", // as above, with spacing handakuten (0x309C).
+	"ト゜", "tu",
+	"ツ゜", "tu",
+	"セ゜", "ce",
+	"チャ", "ca",
+	"チュ", "cu",
+	"チェ", "ce",
+	"チョ", "co",
+	"シャ", "sya",
+	"シュ", "syu",
+	"シェ", "sye",
+	"ショ", "syo",
+	"トゥ", "tu",
+	"イェ", "ye",
+	"ウィ", "wi",
+	"ウェ", "we",
+	"ウォ", "wo",
+	"ア", "a",
+	"イ", "i",
+	"ウ", "u",
+	"エ", "e",
+	"オ", "o",
+	"カ", "ka",
+	"キ", "ki",
+	"ク", "ku",
+	"ケ", "ke",
+	"コ", "ko",
+	"サ", "sa",
+	"シ", "si",
+	"ス", "su",
+	"セ", "se",
+	"ソ", "so",
+	"タ", "ta",
+	"チ", "ci",
+	"ツ", "cu",
+	"テ", "te",
+	"ト", "to",
+	"ナ", "na",
+	"ニ", "ni",
+	"ヌ", "nu",
+	"ネ", "ne",
+	"ノ", "no",
+	"ハ", "ha",
+	"ヒ", "hi",
+	"フ", "hu",
+	"ヘ", "he",
+	"ホ", "ho",
+	"パ", "pa",
+	"ピ", "pi",
+	"プ", "pu",
+	"ペ", "pe",
+	"ポ", "po",
+	"マ", "ma",
+	"ミ", "mi",
+	"ム", "mu",
+	"メ", "me",
+	"モ", "mo",
+	"ヤ", "ya",
+	"ユ", "yu",
+	"ヨ", "yo",
+	"ラ", "ra",
+	"リ", "ri",
+	"ル", "ru",
+	"レ", "re",
+	"ロ", "ro",
+	"ワ", "wa",
+	"ヲ", "wo",
+	"ン", "n",
+	"ァ", "a", // small vowels follow y and w onsets (イェ, ウィ).
+	"ィ", "i",
+	"ゥ", "u",
+	"ェ", "e",
+	"ォ", "o",
+	"ㇰ", "k", // small katakana are final consonants.
+	"ㇱ", "s",
+	"ㇲ", "s",
+	"ㇳ", "t",
+	"ㇴ", "n",
+	"ㇵ", "h",
+	"ㇶ", "h",
+	"ㇷ", "h",
+	"ㇸ", "h",
+	"ㇹ", "h",
+	"ㇺ", "m",
+	"ㇻ", "r",
+	"ㇼ", "r",
+	"ㇽ", "r",
+	"ㇾ", "r",
+	"ㇿ", "r",
+	"ー", "",
+)
+
+// ainuSyllables maps Ainu romaji syllables to Ainu katakana.
+var ainuSyllables = map[string]string{
+	"a": "ア", "i": "イ", "u": "ウ", "e": "エ", "o": "オ",
+	"ka": "カ", "ki": "キ", "ku": "ク", "ke": "ケ", "ko": "コ",
+	"sa": "サ", "si": "シ", "su": "ス", "se": "セ", "so": "ソ",
+	"ta": "タ", "tu": "ト゚", "te": "テ", "to": "ト",
+	"ca": "チャ", "ci": "チ", "cu": "チュ", "ce": "チェ", "co": "チョ",
+	"na": "ナ", "ni": "ニ", "nu": "ヌ", "ne": "ネ", "no": "ノ",
+	"ha": "ハ", "hi": "ヒ", "hu": "フ", "he": "ヘ", "ho": "ホ",
+	"pa": "パ", "pi": "ピ", "pu": "プ", "pe": "ペ", "po": "ポ",
+	"ma": "マ", "mi": "ミ", "mu": "ム", "me": "メ", "mo": "モ",
+	"ya": "ヤ", "yu": "ユ", "ye": "イェ", "yo": "ヨ",
+	"ra": "ラ", "ri": "リ", "ru": "ル", "re": "レ", "ro": "ロ",
+	"wa": "ワ", "wi": "ウィ", "we": "ウェ", "wo": "ウォ",
+	"sya": "シャ", "syu": "シュ", "sye": "シェ", "syo": "ショ",
+}
+
+// ainuFinals maps Ainu romaji consonants which do not precede a vowel to
+// their final consonant katakana. Final h and r are written with the small
+// katakana matching the preceding vowel, and are found in ainuFinalsVowel.
+var ainuFinals = map[rune]string{
+	'k': "ㇰ", 's': "ㇱ", 't': "ㇳ", 'n': "ン", 'm': "ㇺ", 'p': "ㇷ゚", 'y': "イ", 'w': "ウ",
+}
+
+// ainuFinalsVowel maps final h and r to the small katakana used following
+// each vowel.
+var ainuFinalsVowel = map[rune]map[rune]string{
+	'h': {'a': "ㇵ", 'i': "ㇶ", 'u': "ㇷ", 'e': "ㇸ", 'o': "ㇹ"},
+	'r': {'a': "ㇻ", 'i': "ㇼ", 'u': "ㇽ", 'e': "ㇾ", 'o': "ㇿ"},
+}
+
+// kanjiTable contains the CJK Unified Ideographs, Extensions A to I, and the
+// CJK Compatibility Ideographs blocks.
+var kanjiTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1}, // extension a.
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1}, // unified ideographs.
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1}, // compatibility ideographs.
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x20000, Hi: 0x2a6df, Stride: 1}, // extension b.
+		{Lo: 0x2a700, Hi: 0x2ee5f, Stride: 1}, // extensions c, d, e, f and i.
+		{Lo: 0x2f800, Hi: 0x2fa1f, Stride: 1}, // compatibility ideographs supplement.
+		{Lo: 0x30000, Hi: 0x323af, Stride: 1}, // extensions g and h.
+	},
+}
+
+// kanjiRadicalTable contains the CJK Radicals Supplement and Kangxi Radicals
+// blocks.
+var kanjiRadicalTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2e80, Hi: 0x2eff, Stride: 1}, // radicals supplement.
+		{Lo: 0x2f00, Hi: 0x2fdf, Stride: 1}, // kangxi radicals.
+	},
+}
+
+// kanjiMarks contains the iteration and abbreviation marks which are used in
+// place of kanji.
+const kanjiMarks = "々〆〇〻"
+
+// kyoikuKanji contains the 1,026 Kyōiku kanji taught in each grade of
+// elementary school, as revised in 2020, indexed by grade minus one.
+var kyoikuKanji = [6]string{
+	// grade 1.
+	"一右雨円王音下火花貝学気九休玉金空月犬見五口校左三山子四糸字耳七車手十出女小上森" +
+		"人水正生青夕石赤千川先早草足村大男竹中虫町天田土二日入年白八百文木本名目立力林六",
+	// grade 2.
+	"引羽雲園遠何科夏家歌画回会海絵外角楽活間丸岩顔汽記帰弓牛魚京強教近兄形計元言原戸" +
+		"古午後語工公広交光考行高黄合谷国黒今才細作算止市矢姉思紙寺自時室社弱首秋週春書少" +
+		"場色食心新親図数西声星晴切雪船線前組走多太体台地池知茶昼長鳥朝直通弟店点電刀冬当" +
+		"東答頭同道読内南肉馬売買麦半番父風分聞米歩母方北毎妹万明鳴毛門夜野友用曜来里理話",
+	// grade 3.
+	"悪安暗医委意育員院飲運泳駅央横屋温化荷界開階寒感漢館岸起期客究急級宮球去橋業曲局" +
+		"銀区苦具君係軽血決研県庫湖向幸港号根祭皿仕死使始指歯詩次事持式実写者主守取酒受州" +
+		"拾終習集住重宿所暑助昭消商章勝乗植申身神真深進世整昔全相送想息速族他打対待代第題" +
+		"炭短談着注柱丁帳調追定庭笛鉄転都度投豆島湯登等動童農波配倍箱畑発反坂板皮悲美鼻筆" +
+		"氷表秒病品負部服福物平返勉放味命面問役薬由油有遊予羊洋葉陽様落流旅両緑礼列練路和",
+	// grade 4.
+	"愛案以衣位茨印英栄媛塩岡億加果貨課芽賀改械害街各覚潟完官管関観願岐希季旗器機議求" +
+		"泣給挙漁共協鏡競極熊訓軍郡群径景芸欠結建健験固功好香候康佐差菜最埼材崎昨札刷察参" +
+		"産散残氏司試児治滋辞鹿失借種周祝順初松笑唱焼照城縄臣信井成省清静席積折節説浅戦選" +
+		"然争倉巣束側続卒孫帯隊達単置仲沖兆低底的典伝徒努灯働特徳栃奈梨熱念敗梅博阪飯飛必" +
+		"票標不夫付府阜富副兵別辺変便包法望牧末満未民無約勇要養浴利陸良料量輪類令冷例連老" +
+		"労録",
+	// grade 5.
+	"圧囲移因永営衛易益液演応往桜可仮価河過快解格確額刊幹慣眼紀基寄規喜技義逆久旧救居" +
+		"許境均禁句型経潔件険検限現減故個護効厚耕航鉱構興講告混査再災妻採際在財罪殺雑酸賛" +
+		"士支史志枝師資飼示似識質舎謝授修述術準序招証象賞条状常情織職制性政勢精製税責績接" +
+		"設絶祖素総造像増則測属率損貸態団断築貯張停提程適統堂銅導得毒独任燃能破犯判版比肥" +
+		"非費備評貧布婦武復複仏粉編弁保墓報豊防貿暴脈務夢迷綿輸余容略留領歴",
+	// grade 6.
+	"胃異遺域宇映延沿恩我灰拡革閣割株干巻看簡危机揮貴疑吸供胸郷勤筋系敬警劇激穴券絹権" +
+		"憲源厳己呼誤后孝皇紅降鋼刻穀骨困砂座済裁策冊蚕至私姿視詞誌磁射捨尺若樹収宗就衆従" +
+		"縦縮熟純処署諸除承将傷障蒸針仁垂推寸盛聖誠舌宣専泉洗染銭善奏窓創装層操蔵臓存尊退" +
+		"宅担探誕段暖値宙忠著庁頂腸潮賃痛敵展討党糖届難乳認納脳派拝背肺俳班晩否批秘俵腹奮" +
+		"並陛閉片補暮宝訪亡忘棒枚幕密盟模訳郵優預幼欲翌乱卵覧裏律臨朗論",
+}
+
+// joyoSecondaryKanji contains the 1,110 Jōyō kanji which are not Kyōiku
+// kanji, and which are taught in secondary school.
+const joyoSecondaryKanji = "亜哀挨曖握扱宛嵐依威為畏尉萎偉椅彙違維慰緯壱逸芋咽姻淫陰隠韻唄鬱畝浦詠影鋭疫悦越" +
+	"謁閲炎怨宴援煙猿鉛縁艶汚凹押旺欧殴翁奥憶臆虞乙俺卸穏佳苛架華菓渦嫁暇禍靴寡箇稼蚊" +
+	"牙瓦雅餓介戒怪拐悔皆塊楷潰壊懐諧劾崖涯慨蓋該概骸垣柿核殻郭較隔獲嚇穫岳顎掛括喝渇" +
+	"葛滑褐轄且釜鎌刈甘汗缶肝冠陥乾勘患貫喚堪換敢棺款閑勧寛歓監緩憾還環韓艦鑑含玩頑企" +
+	"伎忌奇祈軌既飢鬼亀幾棋棄毀畿輝騎宜偽欺儀戯擬犠菊吉喫詰却脚虐及丘朽臼糾嗅窮巨拒拠" +
+	"虚距御凶叫狂享況峡挟狭恐恭脅矯響驚仰暁凝巾斤菌琴僅緊錦謹襟吟駆惧愚偶遇隅串屈掘窟" +
+	"繰勲薫刑茎契恵啓掲渓蛍傾携継詣慶憬稽憩鶏迎鯨隙撃桁傑肩倹兼剣拳軒圏堅嫌献遣賢謙鍵" +
+	"繭顕懸幻玄弦舷股虎孤弧枯雇誇鼓錮顧互呉娯悟碁勾孔巧甲江坑抗攻更拘肯侯恒洪荒郊貢控" +
+	"梗喉慌硬絞項溝綱酵稿衡購乞拷剛傲豪克酷獄駒込頃昆恨婚痕紺魂墾懇沙唆詐鎖挫采砕宰栽" +
+	"彩斎債催塞歳載剤削柵索酢搾錯咲刹拶撮擦桟惨傘斬暫旨伺刺祉肢施恣脂紫嗣雌摯賜諮侍慈" +
+	"餌璽軸𠮟疾執湿嫉漆芝赦斜煮遮邪蛇酌釈爵寂朱狩殊珠腫趣寿呪需儒囚舟秀臭袖羞愁酬醜蹴" +
+	"襲汁充柔渋銃獣叔淑粛塾俊瞬旬巡盾准殉循潤遵庶緒如叙徐升召匠床抄肖尚昇沼宵症祥称渉" +
+	"紹訟掌晶焦硝粧詔奨詳彰憧衝償礁鐘丈冗浄剰畳壌嬢錠譲醸拭殖飾触嘱辱尻伸芯辛侵津唇娠" +
+	"振浸紳診寝慎審震薪刃尽迅甚陣尋腎須吹炊帥粋衰酔遂睡穂随髄枢崇据杉裾瀬是姓征斉牲凄" +
+	"逝婿誓請醒斥析脊隻惜戚跡籍拙窃摂仙占扇栓旋煎羨腺詮践箋潜遷薦繊鮮禅漸膳繕狙阻租措" +
+	"粗疎訴塑遡礎双壮荘捜挿桑掃曹曽爽喪痩葬僧遭槽踪燥霜騒藻憎贈即促捉俗賊遜汰妥唾堕惰" +
+	"駄耐怠胎泰堆袋逮替滞戴滝択沢卓拓託濯諾濁但脱奪棚誰丹旦胆淡嘆端綻鍛弾壇恥致遅痴稚" +
+	"緻畜逐蓄秩窒嫡抽衷酎鋳駐弔挑彫眺釣貼超跳徴嘲澄聴懲勅捗沈珍朕陳鎮椎墜塚漬坪爪鶴呈" +
+	"廷抵邸亭貞帝訂逓偵堤艇締諦泥摘滴溺迭哲徹撤添塡殿斗吐妬途渡塗賭奴怒到逃倒凍唐桃透" +
+	"悼盗陶塔搭棟痘筒稲踏謄藤闘騰洞胴瞳峠匿督篤凸突屯豚頓貪鈍曇丼那謎鍋軟尼弐匂虹尿妊" +
+	"忍寧捻粘悩濃把覇婆罵杯排廃輩培陪媒賠伯拍泊迫剝舶薄漠縛爆箸肌鉢髪伐抜罰閥氾帆汎伴" +
+	"畔般販斑搬煩頒範繁藩蛮盤妃彼披卑疲被扉碑罷避尾眉微膝肘匹泌姫漂苗描猫浜賓頻敏瓶扶" +
+	"怖附訃赴浮符普腐敷膚賦譜侮舞封伏幅覆払沸紛雰噴墳憤丙併柄塀幣弊蔽餅壁璧癖蔑偏遍哺" +
+	"捕舗募慕簿芳邦奉抱泡胞俸倣峰砲崩蜂飽褒縫乏忙坊妨房肪某冒剖紡傍帽貌膨謀頰朴睦僕墨" +
+	"撲没勃堀奔翻凡盆麻摩磨魔昧埋膜枕又抹慢漫魅岬蜜妙眠矛霧娘冥銘滅免麺茂妄盲耗猛網黙" +
+	"紋冶弥厄躍闇喩愉諭癒唯幽悠湧猶裕雄誘憂融与誉妖庸揚揺溶腰瘍踊窯擁謡抑沃翼拉裸羅雷" +
+	"頼絡酪辣濫藍欄吏痢履璃離慄柳竜粒隆硫侶虜慮了涼猟陵僚寮療瞭糧厘倫隣瑠涙累塁励戻鈴" +
+	"零霊隷齢麗暦劣烈裂恋廉錬呂炉賂露弄郎浪廊楼漏籠麓賄脇惑枠湾腕"
+
+// joyoKanjiAliases maps the commonly used JIS X 0208 forms of Jōyō kanji to
+// the official forms adopted in the 2010 revision of the Jōyō list.
+var joyoKanjiAliases = map[rune]rune{
+	'叱': '𠮟', '填': '塡', '剥': '剝', '頬': '頰',
+}
+
+// jinmeiyoKanji contains the 863 Jinmeiyō kanji which may be used in personal
+// names in addition to the Jōyō kanji. The 647 kanji of the first part of the
+// list are followed by the 216 traditional forms of Jōyō kanji.
+const jinmeiyoKanji = "丑丞乃之乎也云亘亙些亦亥亨亮仔伊伍伽佃佑伶侃侑俄俠俣俐倭俱倦倖偲傭儲允兎兜其冴凌" +
+	"凜凛凧凪凰凱函劉劫勁勺勿匁匡廿卜卯卿厨厩叉叡叢叶只吾吞吻哉哨啄哩喬喧喰喋嘩嘉嘗噌" +
+	"噂圃圭坐尭堯坦埴堰堺堵塙壕壬夷奄奎套娃姪姥娩嬉孟宏宋宕宥寅寓寵尖尤屑峨峻崚嵯嵩嶺" +
+	"巌巖已巳巴巫巷巽帖幌幡庄庇庚庵廟廻弘弛彗彦彪彬徠忽怜恢恰恕悌惟惚悉惇惹惺惣慧憐戊" +
+	"或戟托按挺挽掬捲捷捺捧掠揃摑摺撒撰撞播撫擢孜敦斐斡斧斯於旭昂昊昏昌昴晏晃晄晒晋晟" +
+	"晦晨智暉暢曙曝曳朋朔杏杖杜李杭杵杷枇柑柴柘柊柏柾柚桧檜栞桔桂栖桐栗梧梓梢梛梯桶梶" +
+	"椛梁棲椋椀楯楚楕椿楠楓椰楢楊榎樺榊榛槙槇槍槌樫槻樟樋橘樽橙檎檀櫂櫛櫓欣欽歎此殆毅" +
+	"毘毬汀汝汐汲沌沓沫洸洲洵洛浩浬淵淳渚渚淀淋渥渾湘湊湛溢滉溜漱漕漣澪濡瀕灘灸灼烏焰" +
+	"焚煌煤煉熙燕燎燦燭燿爾牒牟牡牽犀狼猪猪獅玖珂珈珊珀玲琢琢琉瑛琥琶琵琳瑚瑞瑶瑳瓜瓢" +
+	"甥甫畠畢疋疏皐皓眸瞥矩砦砥砧硯碓碗碩碧磐磯祇祢禰祐祐禄祿禎禎禽禾秦秤稀稔稟稜穣穰" +
+	"穿窄窪窺竣竪竺竿笈笹笙笠筈筑箕箔篇篠簞簾籾粥粟糊紘紗紐絃紬絆絢綺綜綴緋綾綸縞徽繫" +
+	"繡纂纏羚翔翠耀而耶耽聡肇肋肴胤胡脩腔膏臥舜舵芥芹芭芙芦苑茄苔苺茅茉茸茜莞荻莫莉菅" +
+	"菫菖萄菩萌萠萊菱葦葵萱葺萩董葡蓑蒔蒐蒼蒲蒙蓉蓮蔭蔣蔦蓬蔓蕎蕨蕉蕃蕪薙蕾蕗藁薩蘇蘭" +
+	"蝦蝶螺蟬蟹蠟衿袈袴裡裟裳襖訊訣註詢詫誼諏諄諒謂諺讃豹貰賑赳跨蹄蹟輔輯輿轟辰辻迂迄" +
+	"辿迪迦這逞逗逢遥遙遁遼邑祁郁鄭酉醇醐醍醬釉釘釧鋒鋸錘錐錆錫鍬鎧閃閏閤阿陀隈隼雀雁" +
+	"雛雫霞靖鞄鞍鞘鞠鞭頁頌頗頸顚颯饗馨馴馳駕駿驍魁魯鮎鯉鯛鰯鱒鱗鳩鳶鳳鴨鴻鵜鵬鷗鷲鷺" +
+	"鷹麒麟麿黎黛鼎亞惡爲衞緣應櫻奧橫溫價壞懷樂渴卷陷寬氣僞戲虛峽狹曉駈勳薰惠揭鷄藝擊" +
+	"縣儉劍險圈檢顯驗嚴廣恆黃國黑碎雜兒濕實壽收從澁獸縱緖敍將涉燒獎條狀乘淨剩疊孃讓釀" +
+	"眞寢愼盡粹醉穗瀨齊靜攝專戰纖禪壯爭莊搜巢曾裝瘦騷增藏臟卽帶滯瀧單團彈晝鑄廳徵聽鎭" +
+	"轉傳嶋燈盜稻德拜盃賣髮拔晚祕冨拂佛步峯飜每萬默埜彌藥與搖樣謠來賴覽龍凉綠淚壘禮曆" +
+	"歷鍊郞錄欄廊朗虜類神祥福諸都侮僧免勉勤卑喝嘆器塀墨層悔慨憎懲敏既暑梅海漢煮碑社祉" +
+	"祈祖祝禍穀突節練繁署者臭著褐視謁謹賓贈逸難響頻"
+
+// kyujitaiPairs contains pairs of shinjitai (new form) kanji followed by the
+// kyūjitai (old form) they replaced. Where several kyūjitai were merged into a
+// single shinjitai, the most common kyūjitai is listed first.
+const kyujitaiPairs = "亜亞悪惡圧壓囲圍為爲医醫壱壹隠隱栄榮営營衛衞駅驛円圓塩鹽縁緣応應欧歐殴毆桜櫻奥奧" +
+	"横橫温溫穏穩仮假価價画畫会會壊壞懐懷絵繪拡擴殻殼覚覺学學岳嶽楽樂渇渴巻卷陥陷勧勸" +
+	"寛寬関關歓歡観觀気氣帰歸既旣亀龜偽僞戯戲犠犧旧舊拠據挙擧峡峽挟挾狭狹郷鄕暁曉区區" +
+	"駆驅勲勳薫薰径徑茎莖恵惠掲揭渓溪経經蛍螢軽輕継繼鶏鷄芸藝撃擊欠缺県縣倹儉剣劍険險" +
+	"圏圈検檢権權献獻験驗顕顯厳嚴広廣効效恒恆黄黃鉱鑛号號国國黒黑歳歲済濟砕碎斎齋剤劑" +
+	"雑雜参參桟棧蚕蠶惨慘賛贊残殘糸絲歯齒児兒辞辭湿濕実實写寫釈釋寿壽収收従從渋澁獣獸" +
+	"縦縱粛肅処處緒緖叙敍将將称稱渉涉焼燒証證奨獎条條状狀乗乘浄淨剰剩畳疊縄繩壌壤嬢孃" +
+	"譲讓醸釀触觸嘱囑真眞寝寢慎愼尽盡図圖粋粹酔醉穂穗随隨髄髓枢樞数數瀬瀨声聲斉齊静靜" +
+	"窃竊摂攝専專浅淺戦戰践踐銭錢潜潛繊纖禅禪双雙壮壯争爭荘莊捜搜挿插巣巢曽曾痩瘦装裝" +
+	"総總騒騷増增蔵藏臓臟即卽属屬続續堕墮対對体體帯帶滞滯台臺滝瀧択擇沢澤担擔単單胆膽" +
+	"団團弾彈断斷痴癡遅遲昼晝虫蟲鋳鑄庁廳徴徵聴聽鎮鎭逓遞鉄鐵転轉点點伝傳灯燈当當党黨" +
+	"盗盜稲稻闘鬭徳德独獨読讀届屆弐貳悩惱脳腦廃廢拝拜売賣麦麥発發髪髮抜拔晩晚蛮蠻秘祕" +
+	"浜濱払拂仏佛並竝変變辺邊弁辯弁辨弁瓣舗舖歩步宝寶豊豐没沒翻飜毎每万萬満滿黙默弥彌" +
+	"訳譯薬藥与與予豫余餘誉譽揺搖様樣謡謠来來頼賴乱亂覧覽竜龍両兩猟獵緑綠塁壘涙淚戻戾" +
+	"励勵礼禮霊靈齢齡暦曆歴歷恋戀炉爐労勞郎郞楼樓録錄湾灣禄祿穣穰遥遙亘亙巌巖尭堯姫姬" +
+	"虚虛剣劒錬鍊"
+
+// kyujitaiCompatPairs contains pairs of kanji followed by the CJK
+// Compatibility Ideograph used to encode their kyūjitai.
+const kyujitaiCompatPairs = "渚渚猪猪琢琢祐祐禎禎欄欄廊廊朗朗虜虜類類神神祥祥福福諸諸都都侮侮僧僧免免勉勉勤勤" +
+	"卑卑喝喝嘆嘆器器塀塀墨墨層層悔悔慨慨憎憎懲懲敏敏既既暑暑梅梅海海漢漢煮煮碑碑社社" +
+	"祉祉祈祈祖祖祝祝禍禍穀穀突突節節練練繁繁署署者者臭臭著著褐褐視視謁謁謹謹賓賓贈贈" +
+	"逸逸難難響響頻頻"
+
+// kanjiDigits maps kanji, daiji, and arabic digits to their values.
+var kanjiDigits = map[rune]int{
+	'〇': 0, '零': 0, '一': 1, '二': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+	'壱': 1, '壹': 1, '弐': 2, '貳': 2, '貮': 2, '参': 3, '參': 3, '肆': 4, '伍': 5, '陸': 6, '漆': 7, '柒': 7, '捌': 8, '玖': 9,
+	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4, '5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
+	'０': 0, '１': 1, '２': 2, '３': 3, '４': 4, '５': 5, '６': 6, '７': 7, '８': 8, '９': 9,
+}
+
+// kanjiSmallUnits maps the kanji and daiji for ten, hundred and thousand to
+// their values.
+var kanjiSmallUnits = map[rune]int{
+	'十': 10, '拾': 10, '百': 100, '佰': 100, '陌': 100, '千': 1000, '阡': 1000, '仟': 1000,
+}
+
+// kanjiLargeUnits maps the kanji for each power of ten thousand to its
+// exponent of ten.
+var kanjiLargeUnits = map[rune]int{
+	'万': 4, '萬': 4, '億': 8, '兆': 12, '京': 16, '垓': 20, '𥝱': 24, '秭': 24,
+	'穣': 28, '溝': 32, '澗': 36, '正': 40, '載': 44, '極': 48,
+}
+
+// kanjiLargeUnitNames contains the kanji for each power of ten thousand,
+// beginning with 万 (10^4).
+var kanjiLargeUnitNames = []string{"万", "億", "兆", "京", "垓", "𥝱", "穣", "溝", "澗", "正", "載", "極"}
+
+// kanjiDigitNames and daijiDigitNames contain the kanji and daiji for each
+// digit, and kanjiSmallUnitNames and daijiSmallUnitNames the kanji and daiji
+// for ten, hundred and thousand.
+var (
+	kanjiDigitNames     = []string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	daijiDigitNames     = []string{"零", "壱", "弐", "参", "四", "五", "六", "七", "八", "九"}
+	kanjiSmallUnitNames = []string{"", "十", "百", "千"}
+	daijiSmallUnitNames = []string{"", "拾", "百", "千"}
+)
+
+// numberReadings contains the readings of each digit in the ones, tens,
+// hundreds and thousands places, including the sound changes of さんびゃく,
+// ろっぴゃく, はっぴゃく, さんぜん and はっせん.
+var numberReadings = [4][10]string{
+	{"", "いち", "に", "さん", "よん", "ご", "ろく", "なな", "はち", "きゅう"},
+	{"", "じゅう", "にじゅう", "さんじゅう", "よんじゅう", "ごじゅう", "ろくじゅう", "ななじゅう", "はちじゅう", "きゅうじゅう"},
+	{"", "ひゃく", "にひゃく", "さんびゃく", "よんひゃく", "ごひゃく", "ろっぴゃく", "ななひゃく", "はっぴゃく", "きゅうひゃく"},
+	{"", "せん", "にせん", "さんぜん", "よんせん", "ごせん", "ろくせん", "ななせん", "はっせん", "きゅうせん"},
+}
+
+// numberLargeUnitReadings contains the readings of each power of ten
+// thousand, beginning with 万 (10^4).
+var numberLargeUnitReadings = []string{"まん", "おく", "ちょう", "けい"}
+
+// numberUnitSoundChanges contains the sound changes of the endings of a group
+// of digits when followed by ちょう or けい, such as いっちょう and ひゃっけい,
+// or by the decimal point てん, such as いってん.
+var numberUnitSoundChanges = map[string][]string{
+	"てん":  {"いち", "いっ", "ろく", "ろっ", "はち", "はっ", "じゅう", "じゅっ"},
+	"ちょう": {"いち", "いっ", "はち", "はっ", "じゅう", "じゅっ"},
+	"けい":  {"いち", "いっ", "ろく", "ろっ", "はち", "はっ", "じゅう", "じゅっ", "ひゃく", "ひゃっ"},
+}
+
+// counters contains the counters known to CounterToKana, keyed by the
+// written counter. Further counters may be added with RegisterCounter.
+var counters = map[string]Counter{
+	"つ": {
+		Numbers: map[int64]string{
+			1: "ひとつ", 2: "ふたつ", 3: "みっつ", 4: "よっつ", 5: "いつつ",
+			6: "むっつ", 7: "ななつ", 8: "やっつ", 9: "ここのつ", 10: "とお",
+		},
+		Fallback: "個",
+	},
+	"人": {
+		Reading: "にん",
+		Endings: map[string]string{"よん": "よにん"},
+		Numbers: map[int64]string{1: "ひとり", 2: "ふたり"},
+	},
+	"日": {
+		Reading: "にち",
+		Endings: map[string]string{"よん": "よっか", "なな": "しちにち", "きゅう": "くにち"},
+		Numbers: map[int64]string{
+			1: "ついたち", 2: "ふつか", 3: "みっか", 4: "よっか", 5: "いつか",
+			6: "むいか", 7: "なのか", 8: "ようか", 9: "ここのか", 10: "とおか", 20: "はつか",
+		},
+	},
+	"本": {Reading: "ほん", Endings: map[string]string{
+		"いち": "いっぽん", "さん": "さんぼん", "ろく": "ろっぽん", "はち": "はっぽん",
+		"じゅう": "じゅっぽん", "ひゃく": "ひゃっぽん", "せん": "せんぼん", "まん": "まんぼん",
+	}},
+	"匹": {Reading: "ひき", Endings: map[string]string{
+		"いち": "いっぴき", "さん": "さんびき", "ろく": "ろっぴき", "はち": "はっぴき",
+		"じゅう": "じゅっぴき", "ひゃく": "ひゃっぴき", "せん": "せんびき", "まん": "まんびき",
+	}},
+	"杯": {Reading: "はい", Endings: map[string]string{
+		"いち": "いっぱい", "さん": "さんばい", "ろく": "ろっぱい", "はち": "はっぱい",
+		"じゅう": "じゅっぱい", "ひゃく": "ひゃっぱい", "せん": "せんばい", "まん": "まんばい",
+	}},
+	"分": {Reading: "ふん", Endings: map[string]string{
+		"いち": "いっぷん", "さん": "さんぷん", "よん": "よんぷん", "ろく": "ろっぷん", "はち": "はっぷん",
+		"じゅう": "じゅっぷん", "ひゃく": "ひゃっぷん", "せん": "せんぷん", "まん": "まんぷん",
+	}},
+	"個": {Reading: "こ", Endings: map[string]string{
+		"いち": "いっこ", "ろく": "ろっこ", "はち": "はっこ", "じゅう": "じゅっこ", "ひゃく": "ひゃっこ",
+	}},
+	"回": {Reading: "かい", Endings: map[string]string{
+		"いち": "いっかい", "ろく": "ろっかい", "はち": "はっかい", "じゅう": "じゅっかい", "ひゃく": "ひゃっかい",
+	}},
+	"階": {Reading: "かい", Endings: map[string]string{
+		"いち": "いっかい", "さん": "さんがい", "ろく": "ろっかい", "はち": "はっかい",
+		"じゅう": "じゅっかい", "ひゃく": "ひゃっかい",
+	}},
+	"軒": {Reading: "けん", Endings: map[string]string{
+		"いち": "いっけん", "さん": "さんげん", "ろく": "ろっけん", "はち": "はっけん",
+		"じゅう": "じゅっけん", "ひゃく": "ひゃっけん",
+	}},
+	"冊": {Reading: "さつ", Endings: map[string]string{
+		"いち": "いっさつ", "はち": "はっさつ", "じゅう": "じゅっさつ",
+	}},
+	"歳": {
+		Reading: "さい",
+		Endings: map[string]string{"いち": "いっさい", "はち": "はっさい", "じゅう": "じゅっさい"},
+		Numbers: map[int64]string{20: "はたち"},
+	},
+	"足": {Reading: "そく", Endings: map[string]string{
+		"いち": "いっそく", "さん": "さんぞく", "はち": "はっそく", "じゅう": "じゅっそく",
+	}},
+	"頭": {Reading: "とう", Endings: map[string]string{
+		"いち": "いっとう", "はち": "はっとう", "じゅう": "じゅっとう",
+	}},
+	"時": {Reading: "じ", Endings: map[string]string{"よん": "よじ", "なな": "しちじ", "きゅう": "くじ"}},
+	"年": {Reading: "ねん", Endings: map[string]string{"よん": "よねん"}},
+	"円": {Reading: "えん", Endings: map[string]string{"よん": "よえん"}},
+	"枚": {Reading: "まい"},
+	"台": {Reading: "だい"},
+	"度": {Reading: "ど"},
+}
+
+// numberUnitVariants maps the voiced readings of the places of a number, as
+// in さんびゃく, ろっぴゃく and さんぜん, to the readings of the places used in
+// the Endings of counters.
+var numberUnitVariants = map[string]string{
+	"びゃく": "ひゃく",
+	"ぴゃく": "ひゃく",
+	"ぜん":  "せん",
+}
+
+// eras contains the Japanese eras from Meiji onwards, in order.
+var eras = []Era{
+	{Name: "明治", Reading: "めいじ", Abbrev: "M", Start: time.Date(1868, 10, 23, 0, 0, 0, 0, time.UTC), End: time.Date(1912, 7, 29, 0, 0, 0, 0, time.UTC)},
+	{Name: "大正", Reading: "たいしょう", Abbrev: "T", Start: time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC), End: time.Date(1926, 12, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "昭和", Reading: "しょうわ", Abbrev: "S", Start: time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC), End: time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC)},
+	{Name: "平成", Reading: "へいせい", Abbrev: "H", Start: time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), End: time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC)},
+	{Name: "令和", Reading: "れいわ", Abbrev: "R", Start: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+}
+
+// eraLigatures maps the single character era name ligatures to era names.
+var eraLigatures = map[rune]string{
+	'㍾': "明治", '㍽': "大正", '㍼': "昭和", '㍻': "平成", '㋿': "令和",
+}
+
+// monthReadings contains the readings of each month, beginning with January.
+var monthReadings = []string{
+	"いちがつ", "にがつ", "さんがつ", "しがつ", "ごがつ", "ろくがつ",
+	"しちがつ", "はちがつ", "くがつ", "じゅうがつ", "じゅういちがつ", "じゅうにがつ",
+}
+
+// halfWidthKana contains the full-width equivalents of the half-width
+// katakana and punctuation from U+FF61 to U+FF9F, in order.
+const halfWidthKana = "。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソ" +
+	"タチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン゛゜"
+
+// gojuonOrder contains the unvoiced full size hiragana in gojūon order.
+const gojuonOrder = "あいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわゐゑをん"
+
+// irohaOrder contains the 47 kana of the iroha poem in order.
+const irohaOrder = "いろはにほへとちりぬるをわかよたれそつねならむうゐのおくやまけふこえてあさきゆめみしゑひもせす"
+
+// longVowelRomaji replaces vowels written with macrons or circumflexes with
+// doubled vowels, such that tōkyō and tôkyô become toukyou.
+var longVowelRomaji = strings.NewReplacer(
+	"ā", "aa", "â", "aa", "ī", "ii", "î", "ii", "ū", "uu", "û", "uu",
+	"ē", "ee", "ê", "ee", "ō", "ou", "ô", "ou",
+	"Ā", "AA", "Â", "AA", "Ī", "II", "Î", "II", "Ū", "UU", "Û", "UU",
+	"Ē", "EE", "Ê", "EE", "Ō", "OU", "Ô", "OU",
+)
+
+// phoneticKana maps kana to the kana they are pronounced as in modern
+// Japanese, for phonetic comparison.
+var phoneticKana = map[rune]rune{
+	'ぢ': 'じ', 'づ': 'ず', 'を': 'お', 'ゐ': 'い', 'ゑ': 'え',
+}
@@ -0,0 +1,75 @@
+package kana
+
+import (
+	"strings"
+)
+
+// ValidateOptions configures the characters which are tolerated by
+// IsHiraganaWith, IsKatakanaWith and IsKanjiWith. The zero value tolerates
+// nothing but the validated script itself.
+type ValidateOptions struct {
+	// Ignore contains characters which are skipped during validation, such
+	// as spaces or the nakaguro (・).
+	Ignore string
+
+	// Allow contains additional script flags which are permitted alongside
+	// the validated script, such as ScriptPunctuation | ScriptDigit.
+	Allow Script
+
+	// AllowMixedKana permits katakana when validating hiragana, and hiragana
+	// when validating katakana.
+	AllowMixedKana bool
+}
+
+// IsHiraganaWith returns true if every element of a string is hiragana, as
+// configured by opts. If the string is not hiragana, the first offending
+// rune and its byte offset are returned. A string which is empty once
+// ignored characters are skipped is not hiragana, and returns an offset of -1.
+func IsHiraganaWith(s string, opts ValidateOptions) (bool, rune, int) {
+	return validate(s, ScriptHiragana, opts)
+}
+
+// IsKatakanaWith returns true if every element of a string is katakana, as
+// configured by opts. If the string is not katakana, the first offending
+// rune and its byte offset are returned. A string which is empty once
+// ignored characters are skipped is not katakana, and returns an offset of -1.
+func IsKatakanaWith(s string, opts ValidateOptions) (bool, rune, int) {
+	return validate(s, ScriptKatakana, opts)
+}
+
+// IsKanjiWith returns true if every element of a string is a kanji character,
+// as configured by opts. If the string is not kanji, the first offending rune
+// and its byte offset are returned. A string which is empty once ignored
+// characters are skipped is not kanji, and returns an offset of -1.
+// AllowMixedKana has no effect on kanji validation.
+func IsKanjiWith(s string, opts ValidateOptions) (bool, rune, int) {
+	return validate(s, ScriptKanji|ScriptKanjiMark, opts)
+}
+
+// validate returns true if every element of a string matches any of the
+// script flags of want, or is permitted by opts. Otherwise, the first
+// offending rune and its byte offset are returned.
+func validate(s string, want Script, opts ValidateOptions) (bool, rune, int) {
+	if opts.AllowMixedKana && want.Is(ScriptHiragana|ScriptKatakana) {
+		want |= ScriptHiragana | ScriptKatakana
+	}
+	want |= opts.Allow
+
+	n := 0
+	for i, r := range s {
+		if strings.ContainsRune(opts.Ignore, r) {
+			continue
+		}
+
+		if !Classify(r).Is(want) {
+			return false, r, i
+		}
+		n++
+	}
+
+	if n == 0 {
+		return false, 0, -1
+	}
+
+	return true, 0, -1
+}
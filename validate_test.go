@@ -0,0 +1,90 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsHiraganaWith(t *testing.T) {
+	tt := []struct {
+		s      string
+		opts   ValidateOptions
+		ok     bool
+		r      rune
+		offset int
+	}{
+		{"ひらがな", ValidateOptions{}, true, 0, -1},
+		{"ひら がな", ValidateOptions{}, false, ' ', 6},
+		{"ひら がな", ValidateOptions{Ignore: " "}, true, 0, -1},
+		{"ひらーがな", ValidateOptions{}, false, 'ー', 6}, // ー is not tolerated by default
+		{"ひらーがな", ValidateOptions{Allow: ScriptChoonpu}, true, 0, -1},
+		{"ひら・がな", ValidateOptions{Ignore: "・"}, true, 0, -1},
+		{"ひら、がな。", ValidateOptions{Allow: ScriptPunctuation}, true, 0, -1},
+		{"ひら1がな", ValidateOptions{Allow: ScriptDigit}, true, 0, -1},
+		{"ひと々", ValidateOptions{}, false, '々', 6},
+		{"ひと々", ValidateOptions{Allow: ScriptKanjiMark}, true, 0, -1},
+		{"ひらカタ", ValidateOptions{}, false, 'カ', 6},
+		{"ひらカタ", ValidateOptions{AllowMixedKana: true}, true, 0, -1},
+		{"ひら水", ValidateOptions{AllowMixedKana: true}, false, '水', 6},
+		{"", ValidateOptions{}, false, 0, -1},
+		{"・", ValidateOptions{Ignore: "・"}, false, 0, -1},
+	}
+
+	for i, v := range tt {
+		ok, r, offset := IsHiraganaWith(v.s, v.opts)
+		require.Equal(t, v.ok, ok, "testing (%d) %s = %v", i, v.s, v.ok)
+		require.Equal(t, v.r, r, "testing (%d) %s rune", i, v.s)
+		require.Equal(t, v.offset, offset, "testing (%d) %s offset", i, v.s)
+	}
+}
+
+func TestIsKatakanaWith(t *testing.T) {
+	tt := []struct {
+		s      string
+		opts   ValidateOptions
+		ok     bool
+		r      rune
+		offset int
+	}{
+		{"カタカナ", ValidateOptions{}, true, 0, -1},
+		{"ジョン・スミス", ValidateOptions{}, false, '・', 9},
+		{"ジョン・スミス", ValidateOptions{Ignore: "・"}, true, 0, -1},
+		{"ラーメン", sanitizeIsChecks, true, 0, -1},
+		{"カタひら", ValidateOptions{AllowMixedKana: true}, true, 0, -1},
+		{"カタひら", ValidateOptions{}, false, 'ひ', 6},
+		{"ｶﾀｶﾅ", ValidateOptions{}, true, 0, -1},
+	}
+
+	for i, v := range tt {
+		ok, r, offset := IsKatakanaWith(v.s, v.opts)
+		require.Equal(t, v.ok, ok, "testing (%d) %s = %v", i, v.s, v.ok)
+		require.Equal(t, v.r, r, "testing (%d) %s rune", i, v.s)
+		require.Equal(t, v.offset, offset, "testing (%d) %s offset", i, v.s)
+	}
+}
+
+func TestIsKanjiWith(t *testing.T) {
+	tt := []struct {
+		s      string
+		opts   ValidateOptions
+		ok     bool
+		r      rune
+		offset int
+	}{
+		{"日本語", ValidateOptions{}, true, 0, -1},
+		{"人々", ValidateOptions{}, true, 0, -1},
+		{"日本 語", ValidateOptions{}, false, ' ', 6},
+		{"日本 語", sanitizeIsChecksKanji, true, 0, -1},
+		{"日本語。", ValidateOptions{Allow: ScriptPunctuation}, true, 0, -1},
+		{"食べる", ValidateOptions{AllowMixedKana: true}, false, 'べ', 3},
+		{"食べる", ValidateOptions{Allow: ScriptHiragana}, true, 0, -1},
+	}
+
+	for i, v := range tt {
+		ok, r, offset := IsKanjiWith(v.s, v.opts)
+		require.Equal(t, v.ok, ok, "testing (%d) %s = %v", i, v.s, v.ok)
+		require.Equal(t, v.r, r, "testing (%d) %s rune", i, v.s)
+		require.Equal(t, v.offset, offset, "testing (%d) %s offset", i, v.s)
+	}
+}
@@ -0,0 +1,276 @@
+package kana
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidWareki indicates a string which is not a valid era-based
+	// (wareki) date.
+	ErrInvalidWareki = errors.New("invalid wareki date")
+
+	// ErrNoEra indicates a date before the beginning of the Meiji era, which
+	// cannot be written as a wareki date.
+	ErrNoEra = errors.New("date precedes the meiji era")
+)
+
+// Era is a Japanese era (gengō), used for era-based (wareki) dates.
+type Era struct {
+	// Name is the name of the era in kanji, such as 令和.
+	Name string
+
+	// Reading is the reading of the era name in hiragana, such as れいわ.
+	Reading string
+
+	// Abbrev is the single letter abbreviation of the era, such as R.
+	Abbrev string
+
+	// Start is the first day of the era, in UTC.
+	Start time.Time
+
+	// End is the last day of the era, in UTC, or the zero time for the
+	// current era.
+	End time.Time
+}
+
+// WarekiStyle indicates how a date is written by FormatWareki.
+type WarekiStyle uint8
+
+const (
+	// WarekiArabic writes dates using kanji with arabic numerals, such as
+	// 令和5年10月16日.
+	WarekiArabic WarekiStyle = iota
+
+	// WarekiKanji writes dates using kanji numerals, such as 令和五年十月十六日.
+	WarekiKanji
+
+	// WarekiAbbrev writes dates using the era abbreviation, such as R5.10.16.
+	WarekiAbbrev
+
+	// WarekiKana writes the reading of dates in hiragana, such as
+	// れいわごねんじゅうがつじゅうろくにち.
+	WarekiKana
+
+	// WarekiRomaji writes the reading of dates in romaji, such as
+	// reiwa gonen juugatsu juurokunichi.
+	WarekiRomaji
+)
+
+// EraOf returns the era of a date and the year of the date within that era,
+// where the first year of an era is 1. ErrNoEra is returned for dates before
+// the Meiji era.
+func EraOf(t time.Time) (Era, int, error) {
+	y, m, d := t.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	for i := len(eras) - 1; i >= 0; i-- {
+		if !date.Before(eras[i].Start) {
+			return eras[i], y - eras[i].Start.Year() + 1, nil
+		}
+	}
+
+	return Era{}, 0, ErrNoEra
+}
+
+// FormatWareki formats the date of t as an era-based (wareki) date in the
+// given style. The first year of an era is written as 元年 (gannen), except
+// in the abbreviated style.
+func FormatWareki(t time.Time, style WarekiStyle) (string, error) {
+	era, year, err := EraOf(t)
+	if err != nil {
+		return "", err
+	}
+
+	_, m, d := t.Date()
+	month, day := int(m), d
+
+	switch style {
+	case WarekiAbbrev:
+		return era.Abbrev + strconv.Itoa(year) + "." + strconv.Itoa(month) + "." + strconv.Itoa(day), nil
+	case WarekiKana, WarekiRomaji:
+		parts := []string{era.Reading, "がんねん", monthReadings[month-1], ""}
+		if year > 1 {
+			parts[1], _ = CounterToKana(int64(year), "年")
+		}
+		parts[3], _ = CounterToKana(int64(day), "日")
+		if style == WarekiKana {
+			return strings.Join(parts, ""), nil
+		}
+		return ToRomaji(strings.Join(parts, " "), false), nil
+	}
+
+	format := strconv.Itoa
+	if style == WarekiKanji {
+		format = func(n int) string {
+			return FormatKanjiNumber(int64(n), NumeralGrouped)
+		}
+	}
+
+	y := "元"
+	if year > 1 {
+		y = format(year)
+	}
+
+	return era.Name + y + "年" + format(month) + "月" + format(day) + "日", nil
+}
+
+// ParseWareki parses an era-based (wareki) date into a time.Time in UTC. The
+// era may be written in kanji (令和), as a ligature (㋿), in romaji (Reiwa,
+// Shōwa or Showa), or abbreviated (R). The year, month and day may be written
+// with kanji or arabic numerals, separated by 年, 月 and 日 or by periods,
+// slashes, hyphens or spaces, as in 令和5年10月16日, 令和五年十月十六日 or
+// R5.10.16. Romaji dates may use nen, gatsu and nichi in place of 年, 月 and
+// 日. The first year of an era may be written as 元年. The month and day may
+// be omitted, in which case the first day of the year, or the first day of
+// the era if later, is returned. Dates after the end of the era are invalid.
+func ParseWareki(s string) (time.Time, error) {
+	era, rest, ok := matchEra(strings.TrimSpace(s))
+	if !ok {
+		return time.Time{}, ErrInvalidWareki
+	}
+
+	nums, ok := parseWarekiNumbers(rest)
+	if !ok || nums[0] < 1 {
+		return time.Time{}, ErrInvalidWareki
+	}
+
+	month, day := 1, 1
+	if len(nums) > 1 {
+		month = nums[1]
+	}
+	if len(nums) > 2 {
+		day = nums[2]
+	}
+
+	t := time.Date(era.Start.Year()+nums[0]-1, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || t.Day() != day {
+		return time.Time{}, ErrInvalidWareki
+	}
+
+	if !era.End.IsZero() && t.After(era.End) {
+		return time.Time{}, ErrInvalidWareki
+	}
+
+	if t.Before(era.Start) {
+		if len(nums) == 3 {
+			return time.Time{}, ErrInvalidWareki
+		}
+		t = era.Start
+	}
+
+	return t, nil
+}
+
+// matchEra returns the era which a string begins with, and the remainder of
+// the string following the era.
+func matchEra(s string) (Era, string, bool) {
+	for r, name := range eraLigatures {
+		if strings.HasPrefix(s, string(r)) {
+			s = name + s[len(string(r)):]
+			break
+		}
+	}
+
+	lower := strings.ToLower(s)
+	for _, era := range eras {
+		if strings.HasPrefix(s, era.Name) {
+			return era, s[len(era.Name):], true
+		}
+
+		romaji := ToRomaji(era.Reading, false)
+		for _, name := range []string{romaji, macronRomaji.Replace(romaji), strings.ReplaceAll(romaji, "ou", "o")} {
+			if strings.HasPrefix(lower, name) {
+				return era, s[len(name):], true
+			}
+		}
+	}
+
+	for _, era := range eras {
+		for _, abbrev := range []string{era.Abbrev, strings.ToLower(era.Abbrev), string(rune(era.Abbrev[0]) - 'A' + 'Ａ')} {
+			if strings.HasPrefix(s, abbrev) {
+				return era, s[len(abbrev):], true
+			}
+		}
+	}
+
+	return Era{}, "", false
+}
+
+// parseWarekiNumbers returns the year, and the month and day if present,
+// from the remainder of a wareki date following the era.
+func parseWarekiNumbers(s string) ([]int, bool) {
+	nums := []int{}
+	run := []rune{}
+	flush := func() bool {
+		if len(run) == 0 {
+			return true
+		}
+
+		n := 1
+		if string(run) != "元" {
+			v, err := ParseKanjiNumber(string(run))
+			if err != nil {
+				return false
+			}
+			n = int(v)
+		} else if len(nums) > 0 {
+			return false
+		}
+
+		nums = append(nums, n)
+		run = run[:0]
+		return true
+	}
+
+	for i := 0; i < len(s); {
+		if n := warekiRomajiSeparator(s[i:]); n > 0 {
+			if !flush() {
+				return nil, false
+			}
+			i += n
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		_, digit := kanjiDigits[r]
+		_, unit := kanjiSmallUnits[r]
+		switch {
+		case digit, unit, r == '元':
+			run = append(run, r)
+		case isWarekiSeparator(r):
+			if !flush() {
+				return nil, false
+			}
+		default:
+			return nil, false
+		}
+	}
+
+	if !flush() || len(nums) == 0 || len(nums) > 3 {
+		return nil, false
+	}
+
+	return nums, true
+}
+
+// isWarekiSeparator returns true if a rune may separate the year, month and
+// day of a wareki date.
+func isWarekiSeparator(r rune) bool {
+	return strings.ContainsRune("年月日.．/／-－ 　", r)
+}
+
+// warekiRomajiSeparator returns the length of the romaji year, month or day
+// suffix (nen, gatsu or nichi) which a string begins with, or 0 if it does
+// not begin with one.
+func warekiRomajiSeparator(s string) int {
+	for _, sep := range []string{"nen", "gatsu", "nichi"} {
+		if len(s) >= len(sep) && strings.EqualFold(s[:len(sep)], sep) {
+			return len(sep)
+		}
+	}
+	return 0
+}
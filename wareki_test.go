@@ -0,0 +1,107 @@
+package kana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func warekiDate(y, m, d int) time.Time {
+	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseWareki(t *testing.T) {
+	tt := []struct {
+		s   string
+		r   time.Time
+		err error
+	}{
+		{"令和5年10月16日", warekiDate(2023, 10, 16), nil},
+		{"令和五年十月十六日", warekiDate(2023, 10, 16), nil},
+		{"令和元年5月1日", warekiDate(2019, 5, 1), nil},
+		{"令和元年", warekiDate(2019, 5, 1), nil},
+		{"平成三十一年", warekiDate(2019, 1, 1), nil},
+		{"平成31年4月30日", warekiDate(2019, 4, 30), nil},
+		{"平成元年1月", warekiDate(1989, 1, 8), nil},
+		{"昭和64年1月7日", warekiDate(1989, 1, 7), nil},
+		{"大正15年", warekiDate(1926, 1, 1), nil},
+		{"明治45年7月29日", warekiDate(1912, 7, 29), nil},
+		{"R5.10.16", warekiDate(2023, 10, 16), nil},
+		{"R05/10/16", warekiDate(2023, 10, 16), nil},
+		{"h31-4-30", warekiDate(2019, 4, 30), nil},
+		{"Ｓ６４．１．７", warekiDate(1989, 1, 7), nil},
+		{"㋿5年10月16日", warekiDate(2023, 10, 16), nil},
+		{"Reiwa 5.10.16", warekiDate(2023, 10, 16), nil},
+		{"Shōwa 50", warekiDate(1975, 1, 1), nil},
+		{"Showa 50", warekiDate(1975, 1, 1), nil},
+		{"shouwa 50", warekiDate(1975, 1, 1), nil},
+		{"Reiwa 5-nen 10-gatsu 16-nichi", warekiDate(2023, 10, 16), nil},
+		{"令和元年4月30日", time.Time{}, ErrInvalidWareki},
+		{"令和5年13月1日", time.Time{}, ErrInvalidWareki},
+		{"令和5年2月30日", time.Time{}, ErrInvalidWareki},
+		{"令和5年10月16日1", time.Time{}, ErrInvalidWareki},
+		{"令和5年元月", time.Time{}, ErrInvalidWareki},
+		{"令和0年", time.Time{}, ErrInvalidWareki},
+		{"令和", time.Time{}, ErrInvalidWareki},
+		{"慶応3年", time.Time{}, ErrInvalidWareki},
+		{"2023年10月16日", time.Time{}, ErrInvalidWareki},
+		{"令和5年foo", time.Time{}, ErrInvalidWareki},
+		{"令和xyz5", time.Time{}, ErrInvalidWareki},
+		{"Reiwa 5 gatsuo", time.Time{}, ErrInvalidWareki},
+		{"平成99年", time.Time{}, ErrInvalidWareki},
+		{"平成32年", time.Time{}, ErrInvalidWareki},
+		{"平成31年5月1日", time.Time{}, ErrInvalidWareki},
+		{"昭和64年1月8日", time.Time{}, ErrInvalidWareki},
+		{"明治46年", time.Time{}, ErrInvalidWareki},
+	}
+
+	for i, v := range tt {
+		r, err := ParseWareki(v.s)
+		require.Equal(t, v.err, err, "testing (%d) %s", i, v.s)
+		require.Equal(t, v.r, r, "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}
+
+func TestFormatWareki(t *testing.T) {
+	tt := []struct {
+		t     time.Time
+		style WarekiStyle
+		r     string
+	}{
+		{warekiDate(2023, 10, 16), WarekiArabic, "令和5年10月16日"},
+		{warekiDate(2023, 10, 16), WarekiKanji, "令和五年十月十六日"},
+		{warekiDate(2023, 10, 16), WarekiAbbrev, "R5.10.16"},
+		{warekiDate(2023, 10, 16), WarekiKana, "れいわごねんじゅうがつじゅうろくにち"},
+		{warekiDate(2023, 10, 16), WarekiRomaji, "reiwa gonen juugatsu juurokunichi"},
+		{warekiDate(2019, 5, 1), WarekiArabic, "令和元年5月1日"},
+		{warekiDate(2019, 5, 1), WarekiAbbrev, "R1.5.1"},
+		{warekiDate(2019, 5, 1), WarekiKana, "れいわがんねんごがつついたち"},
+		{warekiDate(2019, 4, 30), WarekiArabic, "平成31年4月30日"},
+		{warekiDate(2022, 4, 4), WarekiKana, "れいわよねんしがつよっか"},
+		{warekiDate(1989, 1, 7), WarekiKanji, "昭和六十四年一月七日"},
+		{warekiDate(1926, 12, 25), WarekiRomaji, "shouwa gannen juunigatsu nijuugonichi"},
+		{time.Date(2023, 10, 16, 23, 0, 0, 0, time.FixedZone("JST", 9*60*60)), WarekiArabic, "令和5年10月16日"},
+	}
+
+	for i, v := range tt {
+		r, err := FormatWareki(v.t, v.style)
+		require.NoError(t, err)
+		require.Equal(t, v.r, r, "testing (%d) %v = %v", i, v.t, v.r)
+	}
+
+	_, err := FormatWareki(warekiDate(1868, 1, 1), WarekiArabic)
+	require.Equal(t, ErrNoEra, err)
+}
+
+func TestEraOf(t *testing.T) {
+	era, year, err := EraOf(warekiDate(2019, 5, 1))
+	require.NoError(t, err)
+	require.Equal(t, "令和", era.Name)
+	require.Equal(t, 1, year)
+
+	era, year, err = EraOf(warekiDate(2019, 4, 30))
+	require.NoError(t, err)
+	require.Equal(t, "平成", era.Name)
+	require.Equal(t, 31, year)
+}
@@ -0,0 +1,38 @@
+package kana
+
+import "strings"
+
+// normalizeWidth converts half-width katakana and punctuation to full-width,
+// full-width ASCII characters to ASCII, and the ideographic space to a space.
+// Half-width, combining, and standalone voiced and semi-voiced sound marks
+// are combined with the preceding kana where possible.
+func normalizeWidth(s string) string {
+	halfWidth := []rune(halfWidthKana)
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= 0xff01 && r <= 0xff5e:
+			r -= 0xff01 - '!'
+		case r == '　':
+			r = ' '
+		case r >= 0xff61 && r <= 0xff9f:
+			r = halfWidth[r-0xff61]
+		}
+
+		if n := len(out); n > 0 && strings.ContainsRune("゛゙゜゚", r) {
+			prev := out[n-1]
+			m := voicedKana
+			if r == '゜' || r == '゚' {
+				m = semivoicedKana
+			}
+			if v, ok := m[prev]; ok {
+				out[n-1] = v
+				continue
+			}
+		}
+
+		out = append(out, r)
+	}
+
+	return string(out)
+}
@@ -0,0 +1,28 @@
+package kana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeWidth(t *testing.T) {
+	tt := []struct {
+		s string
+		r string
+	}{
+		{"ｶﾀｶﾅ", "カタカナ"},
+		{"ｶﾞｷﾞﾊﾟｳﾞ", "ガギパヴ"},
+		{"ﾞｱ", "゛ア"},
+		{"ｱﾞ", "ア゛"},
+		{"ｰ｡｢｣､･", "ー。「」、・"},
+		{"ＡＢＣ１２３！", "ABC123!"},
+		{"がき゛ぱ", "がぎぱ"},
+		{"　", " "},
+		{"ひらがな", "ひらがな"},
+	}
+
+	for i, v := range tt {
+		require.Equal(t, v.r, normalizeWidth(v.s), "testing (%d) %s = %v", i, v.s, v.r)
+	}
+}